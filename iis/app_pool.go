@@ -15,6 +15,7 @@ type ApplicationPool struct {
 	ID                    string              `json:"id"`
 	Status                string              `json:"status"`
 	AutoStart             bool                `json:"auto_start"`
+	StartMode             string              `json:"start_mode"`
 	PipelineMode          string              `json:"pipeline_mode"`
 	ManagedRuntimeVersion string              `json:"managed_runtime_version"`
 	Enable32BitWin64      bool                `json:"enable_32bit_win64"`
@@ -28,29 +29,32 @@ type ApplicationPool struct {
 }
 
 type CPU struct {
-	Limit int64 `json:"limit"`
-	//LimitInterval            int64  `json:"limit_interval"`
-	Action                   string `json:"action"`
-	ProcessorAffinityEnabled bool   `json:"processor_affinity_enabled"`
-	ProcessorAffinityMask32  string `json:"processor_affinity_mask32"`
-	ProcessorAffinityMask64  string `json:"processor_affinity_mask64"`
+	Limit                    int64   `json:"limit"`
+	LimitInterval            Minutes `json:"limit_interval"`
+	Action                   string  `json:"action"`
+	ProcessorAffinityEnabled bool    `json:"processor_affinity_enabled"`
+	ProcessorAffinityMask32  string  `json:"processor_affinity_mask32"`
+	ProcessorAffinityMask64  string  `json:"processor_affinity_mask64"`
 }
 
 type Identity struct {
-	IdentityType    string `json:"identity_type"`
-	Username        string `json:"username"`
+	IdentityType string `json:"identity_type"`
+	Username     string `json:"username"`
+	// Password of a SpecificUser identity, it is never returned by the API
+	// and only sent if set, so the current password is kept otherwise
+	Password        string `json:"password,omitempty"`
 	LoadUserProfile bool   `json:"load_user_profile"`
 }
 
 type ProcessModel struct {
-	//IdleTimeout       int64  `json:"idle_timeout"`
-	MaxProcesses   int64 `json:"max_processes"`
-	PingingEnabled bool  `json:"pinging_enabled"`
-	//PingInterval      int64  `json:"ping_interval"`
-	//PingResponseTime  int64  `json:"ping_response_time"`
-	//ShutdownTimeLimit int64  `json:"shutdown_time_limit"`
-	//StartupTimeLimit  int64  `json:"startup_time_limit"`
-	IdleTimeoutAction string `json:"idle_timeout_action"`
+	IdleTimeout       Minutes `json:"idle_timeout"`
+	MaxProcesses      int64   `json:"max_processes"`
+	PingingEnabled    bool    `json:"pinging_enabled"`
+	PingInterval      Seconds `json:"ping_interval"`
+	PingResponseTime  Seconds `json:"ping_response_time"`
+	ShutdownTimeLimit Seconds `json:"shutdown_time_limit"`
+	StartupTimeLimit  Seconds `json:"startup_time_limit"`
+	IdleTimeoutAction string  `json:"idle_timeout_action"`
 }
 
 type ProcessOrphaning struct {
@@ -60,12 +64,12 @@ type ProcessOrphaning struct {
 }
 
 type RapidFailProtection struct {
-	Enabled                  bool   `json:"enabled"`
-	LoadBalancerCapabilities string `json:"load_balancer_capabilities"`
-	//Interval                 int64  `json:"interval"`
-	MaxCrashes         int64  `json:"max_crashes"`
-	AutoShutdownExe    string `json:"auto_shutdown_exe"`
-	AutoShutdownParams string `json:"auto_shutdown_params"`
+	Enabled                  bool    `json:"enabled"`
+	LoadBalancerCapabilities string  `json:"load_balancer_capabilities"`
+	Interval                 Minutes `json:"interval"`
+	MaxCrashes               int64   `json:"max_crashes"`
+	AutoShutdownExe          string  `json:"auto_shutdown_exe"`
+	AutoShutdownParams       string  `json:"auto_shutdown_params"`
 }
 
 type Recycling struct {
@@ -87,11 +91,11 @@ type LogEvents struct {
 }
 
 type PeriodicRestart struct {
-	//TimeInterval  int64         `json:"time_interval"`
-	PrivateMemory int64         `json:"private_memory"`
-	RequestLimit  int64         `json:"request_limit"`
-	VirtualMemory int64         `json:"virtual_memory"`
-	Schedule      []interface{} `json:"schedule"`
+	TimeInterval  Minutes     `json:"time_interval"`
+	PrivateMemory int64       `json:"private_memory"`
+	RequestLimit  int64       `json:"request_limit"`
+	VirtualMemory int64       `json:"virtual_memory"`
+	Schedule      []TimeOfDay `json:"schedule,omitempty"`
 }
 
 func (client Client) ReadAppPool(ctx context.Context, id string) (*ApplicationPool, error) {
@@ -6,23 +6,47 @@ import (
 	"fmt"
 )
 
-func (client Client) UpdateAppPool(ctx context.Context, id, runtimeVersion, status string) (*ApplicationPool, error) {
-	reqBody := struct {
-		ManagedRuntimeVersion string `json:"managed_runtime_version,omitempty"`
-		Status                string `json:"status,omitempty"`
-	}{
-		ManagedRuntimeVersion: runtimeVersion,
-		Status:                status,
-	}
+// UpdateAppPoolRequest holds the settings of an application pool which can be
+// changed, settings which are nil are not sent and keep their current value
+type UpdateAppPoolRequest struct {
+	Status                *string                 `json:"status,omitempty"`
+	ManagedRuntimeVersion *string                 `json:"managed_runtime_version,omitempty"`
+	AutoStart             *bool                   `json:"auto_start,omitempty"`
+	StartMode             *string                 `json:"start_mode,omitempty"`
+	PipelineMode          *string                 `json:"pipeline_mode,omitempty"`
+	Enable32BitWin64      *bool                   `json:"enable_32bit_win64,omitempty"`
+	QueueLength           *int64                  `json:"queue_length,omitempty"`
+	CPU                   *CPU                    `json:"cpu,omitempty"`
+	ProcessModel          *ProcessModel           `json:"process_model,omitempty"`
+	Identity              *Identity               `json:"identity,omitempty"`
+	Recycling             *UpdateRecyclingRequest `json:"recycling,omitempty"`
+	RapidFailProtection   *RapidFailProtection    `json:"rapid_fail_protection,omitempty"`
+	ProcessOrphaning      *ProcessOrphaning       `json:"process_orphaning,omitempty"`
+}
+
+// UpdateRecyclingRequest holds the recycling settings, the schedule is only
+// sent if set and an empty schedule removes all scheduled recycles
+type UpdateRecyclingRequest struct {
+	Recycling
+	PeriodicRestart UpdatePeriodicRestartRequest `json:"periodic_restart"`
+}
+
+type UpdatePeriodicRestartRequest struct {
+	PeriodicRestart
+	Schedule *[]TimeOfDay `json:"schedule,omitempty"`
+}
+
+// UpdateAppPool patches the settings of the request, all other settings are kept
+func (client Client) UpdateAppPool(ctx context.Context, id string, request UpdateAppPoolRequest) (*ApplicationPool, error) {
 	url := fmt.Sprintf("/api/webserver/application-pools/%s", id)
-	res, err := httpPatch(ctx, client, url, reqBody)
+	res, err := httpPatch(ctx, client, url, request)
 	if err != nil {
 		return nil, err
 	}
-	var pool ApplicationPool
-	err = json.Unmarshal(res, &pool)
+	var updated ApplicationPool
+	err = json.Unmarshal(res, &updated)
 	if err != nil {
 		return nil, err
 	}
-	return &pool, nil
+	return &updated, nil
 }
@@ -46,3 +46,16 @@ func (client Client) DeleteApplication(ctx context.Context, id string) error {
 	url := fmt.Sprintf("/api/webserver/webapps/%s", id)
 	return httpDelete(ctx, client, url)
 }
+
+type ApplicationListResponse struct {
+	Applications []Application `json:"webapps"`
+}
+
+// ListApplications lists the applications of a website
+func (client Client) ListApplications(ctx context.Context, websiteID string) ([]Application, error) {
+	var res ApplicationListResponse
+	if err := getJson(ctx, client, "/api/webserver/webapps?website.id="+websiteID, &res); err != nil {
+		return nil, err
+	}
+	return res.Applications, nil
+}
@@ -0,0 +1,38 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const authorizationPath = "/api/webserver/authorization"
+
+// Authorization is the URL authorization of a scope, which decides who is
+// allowed in after authentication
+type Authorization struct {
+	Feature
+	BypassLoginPages bool `json:"bypass_login_pages"`
+}
+
+func (client Client) ReadAuthorization(ctx context.Context, scope Scope) (*Authorization, error) {
+	var authorization Authorization
+	if err := getJson(ctx, client, featurePath(authorizationPath, scope), &authorization); err != nil {
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+func (client Client) ReadAuthorizationByID(ctx context.Context, id string) (*Authorization, error) {
+	url := fmt.Sprintf("%s/%s", authorizationPath, id)
+	var authorization Authorization
+	if err := getJson(ctx, client, url, &authorization); err != nil {
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+// DeleteAuthorization removes the local authorization rules of its scope, so
+// the rules are inherited again
+func (client Client) DeleteAuthorization(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, authorizationPath, id)
+}
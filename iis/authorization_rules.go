@@ -0,0 +1,32 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const authorizationRulesPath = authorizationPath + "/rules"
+
+// AuthorizationRule allows or denies users and roles, the lists are comma separated.
+// Rules are evaluated in order, new rules are added at the end.
+type AuthorizationRule struct {
+	AccessType    string     `json:"access_type"`
+	Users         string     `json:"users"`
+	Roles         string     `json:"roles"`
+	Verbs         string     `json:"verbs"`
+	ID            string     `json:"id,omitempty"`
+	Authorization *Reference `json:"authorization,omitempty"`
+}
+
+func (client Client) ListAuthorizationRules(ctx context.Context, authorizationID string) ([]AuthorizationRule, error) {
+	return listFeatureEntries[AuthorizationRule](ctx, client, authorizationRulesPath, "rules", "authorization.id", authorizationID)
+}
+
+func (client Client) CreateAuthorizationRule(ctx context.Context, authorizationID string, rule AuthorizationRule) (*AuthorizationRule, error) {
+	rule.Authorization = &Reference{ID: authorizationID}
+	return createFeatureEntry(ctx, client, authorizationRulesPath, rule)
+}
+
+func (client Client) DeleteAuthorizationRule(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", authorizationRulesPath, id))
+}
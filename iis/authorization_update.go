@@ -0,0 +1,24 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (client Client) UpdateAuthorization(ctx context.Context, id string, request UpdateAuthorizationRequest) (*Authorization, error) {
+	url := fmt.Sprintf("%s/%s", authorizationPath, id)
+	res, err := httpPatch(ctx, client, url, request)
+	if err != nil {
+		return nil, err
+	}
+	var authorization Authorization
+	if err := json.Unmarshal(res, &authorization); err != nil {
+		return nil, err
+	}
+	return &authorization, nil
+}
+
+type UpdateAuthorizationRequest struct {
+	BypassLoginPages bool `json:"bypass_login_pages"`
+}
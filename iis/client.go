@@ -10,4 +10,6 @@ type Client struct {
 	NTLMUsername string
 	NTLMPassword string
 	NTLMDomain   string
+	// RetryPolicy controls retries of failed requests, DefaultRetryPolicy is used if nil
+	RetryPolicy *RetryPolicy
 }
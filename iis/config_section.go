@@ -0,0 +1,66 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ConfigSection is a feature of the IIS Administration API as plain JSON, for
+// features which have no model in this client
+type ConfigSection map[string]interface{}
+
+// Href returns the url of the section from its HAL self link
+func (section ConfigSection) Href() (string, error) {
+	links, _ := section["_links"].(map[string]interface{})
+	self, _ := links["self"].(map[string]interface{})
+	href, _ := self["href"].(string)
+	if href == "" {
+		return "", fmt.Errorf("section %v has no self link", section["id"])
+	}
+	return href, nil
+}
+
+// Scope returns the location the section is configured for, e.g.
+// "Default Web Site/app", it is empty at server level
+func (section ConfigSection) Scope() string {
+	scope, _ := section["scope"].(string)
+	return scope
+}
+
+// ReadConfigSection returns the feature at a path below /api, e.g.
+// "webserver/http-response-headers", for the location of a website or application
+func (client Client) ReadConfigSection(ctx context.Context, path, scope string) (ConfigSection, error) {
+	sectionPath := "/api/" + path
+	if scope != "" {
+		sectionPath += "?scope=" + url.QueryEscape(scope)
+	}
+	var section ConfigSection
+	if err := getJson(ctx, client, sectionPath, &section); err != nil {
+		return nil, err
+	}
+	return section, nil
+}
+
+// ReadConfigSectionByHref returns the section linked by its self link
+func (client Client) ReadConfigSectionByHref(ctx context.Context, href string) (ConfigSection, error) {
+	var section ConfigSection
+	if err := getJson(ctx, client, href, &section); err != nil {
+		return nil, err
+	}
+	return section, nil
+}
+
+// UpdateConfigSection patches the given settings of a section, other settings are kept
+func (client Client) UpdateConfigSection(ctx context.Context, href string, settings map[string]interface{}) (ConfigSection, error) {
+	res, err := httpPatch(ctx, client, href, settings)
+	if err != nil {
+		return nil, err
+	}
+	var section ConfigSection
+	if err := json.Unmarshal(res, &section); err != nil {
+		return nil, err
+	}
+	return section, nil
+}
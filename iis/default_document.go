@@ -0,0 +1,36 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const defaultDocumentPath = "/api/webserver/default-documents"
+
+type DefaultDocument struct {
+	Feature
+	Enabled bool `json:"enabled"`
+}
+
+func (client Client) ReadDefaultDocument(ctx context.Context, scope Scope) (*DefaultDocument, error) {
+	var document DefaultDocument
+	if err := getJson(ctx, client, featurePath(defaultDocumentPath, scope), &document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+func (client Client) ReadDefaultDocumentByID(ctx context.Context, id string) (*DefaultDocument, error) {
+	url := fmt.Sprintf("%s/%s", defaultDocumentPath, id)
+	var document DefaultDocument
+	if err := getJson(ctx, client, url, &document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+// DeleteDefaultDocument removes the local default document configuration of
+// its scope, so the documents are inherited again
+func (client Client) DeleteDefaultDocument(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, defaultDocumentPath, id)
+}
@@ -0,0 +1,50 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const defaultDocumentFilesPath = "/api/webserver/default-documents/files"
+
+// DefaultDocumentFile is an entry of the ordered default document list
+type DefaultDocumentFile struct {
+	Name            string     `json:"name"`
+	ID              string     `json:"id,omitempty"`
+	DefaultDocument *Reference `json:"default_document,omitempty"`
+}
+
+type DefaultDocumentFileListResponse struct {
+	Files []DefaultDocumentFile `json:"files"`
+}
+
+// ListDefaultDocumentFiles returns the default documents of a scope in the order IIS tries them
+func (client Client) ListDefaultDocumentFiles(ctx context.Context, defaultDocumentID string) ([]DefaultDocumentFile, error) {
+	var res DefaultDocumentFileListResponse
+	path := defaultDocumentFilesPath + "?default_document.id=" + url.QueryEscape(defaultDocumentID)
+	if err := getJson(ctx, client, path, &res); err != nil {
+		return nil, err
+	}
+	return res.Files, nil
+}
+
+// AddDefaultDocumentFile adds a document in front of the existing documents
+func (client Client) AddDefaultDocumentFile(ctx context.Context, defaultDocumentID, name string) (*DefaultDocumentFile, error) {
+	request := DefaultDocumentFile{Name: name, DefaultDocument: &Reference{ID: defaultDocumentID}}
+	res, err := httpPost(ctx, client, defaultDocumentFilesPath, request)
+	if err != nil {
+		return nil, err
+	}
+	var file DefaultDocumentFile
+	if err := json.Unmarshal(res, &file); err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+func (client Client) DeleteDefaultDocumentFile(ctx context.Context, id string) error {
+	url := fmt.Sprintf("%s/%s", defaultDocumentFilesPath, id)
+	return httpDelete(ctx, client, url)
+}
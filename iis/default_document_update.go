@@ -0,0 +1,24 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (client Client) UpdateDefaultDocument(ctx context.Context, id string, request UpdateDefaultDocumentRequest) (*DefaultDocument, error) {
+	url := fmt.Sprintf("%s/%s", defaultDocumentPath, id)
+	res, err := httpPatch(ctx, client, url, request)
+	if err != nil {
+		return nil, err
+	}
+	var document DefaultDocument
+	if err := json.Unmarshal(res, &document); err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+type UpdateDefaultDocumentRequest struct {
+	Enabled bool `json:"enabled"`
+}
@@ -0,0 +1,47 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const directoryBrowsingPath = "/api/webserver/directory-browsing"
+
+// DirectoryBrowsing decides whether directories without a default document are listed
+type DirectoryBrowsing struct {
+	Feature
+	Enabled           bool                      `json:"enabled"`
+	AllowedAttributes DirectoryBrowseAttributes `json:"allowed_attributes"`
+}
+
+// DirectoryBrowseAttributes are the columns shown in a directory listing
+type DirectoryBrowseAttributes struct {
+	Date      bool `json:"date"`
+	Time      bool `json:"time"`
+	Size      bool `json:"size"`
+	Extension bool `json:"extension"`
+	LongDate  bool `json:"long_date"`
+}
+
+func (client Client) ReadDirectoryBrowsing(ctx context.Context, scope Scope) (*DirectoryBrowsing, error) {
+	var browsing DirectoryBrowsing
+	if err := getJson(ctx, client, featurePath(directoryBrowsingPath, scope), &browsing); err != nil {
+		return nil, err
+	}
+	return &browsing, nil
+}
+
+func (client Client) ReadDirectoryBrowsingByID(ctx context.Context, id string) (*DirectoryBrowsing, error) {
+	url := fmt.Sprintf("%s/%s", directoryBrowsingPath, id)
+	var browsing DirectoryBrowsing
+	if err := getJson(ctx, client, url, &browsing); err != nil {
+		return nil, err
+	}
+	return &browsing, nil
+}
+
+// DeleteDirectoryBrowsing removes the local directory browsing settings of its
+// scope, so the settings are inherited again
+func (client Client) DeleteDirectoryBrowsing(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, directoryBrowsingPath, id)
+}
@@ -0,0 +1,10 @@
+package iis
+
+import (
+	"context"
+)
+
+// UpdateDirectoryBrowsing replaces the directory browsing settings
+func (client Client) UpdateDirectoryBrowsing(ctx context.Context, browsing DirectoryBrowsing) (*DirectoryBrowsing, error) {
+	return updateFeature(ctx, client, directoryBrowsingPath, browsing)
+}
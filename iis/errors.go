@@ -0,0 +1,119 @@
+package iis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrSectionLocked is matched by errors.Is for writes to a configuration
+// section which is locked by the override mode of a parent scope
+var ErrSectionLocked = errors.New("the configuration section is locked at a parent scope, its override mode has to allow changes at this scope")
+
+// APIError is returned for every response of the IIS Administration API with a
+// non-successful status code. Use errors.As to inspect it.
+type APIError struct {
+	Method     string
+	URL        string
+	StatusCode int
+	Status     string
+	// Title, Detail and Name are parsed from the problem JSON body returned by
+	// the IIS Administration API. Name holds the offending property, if any.
+	Title  string
+	Detail string
+	Name   string
+	// Body is the raw response body
+	Body string
+}
+
+// problemDetails is the error payload of the IIS Administration API, e.g.
+// {"title":"Conflict","detail":"Already exists","name":"name","status":409}
+type problemDetails struct {
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Name   string `json:"name"`
+	Status int    `json:"status"`
+}
+
+func newAPIError(method, url string, response *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		Method:     method,
+		URL:        url,
+		StatusCode: response.StatusCode,
+		Status:     response.Status,
+		Body:       string(body),
+	}
+	var problem problemDetails
+	if err := json.Unmarshal(body, &problem); err == nil {
+		apiErr.Title = problem.Title
+		apiErr.Detail = problem.Detail
+		apiErr.Name = problem.Name
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("%s %s returned invalid status code: %s", e.Method, e.URL, e.Status)
+	if e.Title == "" && e.Detail == "" {
+		if body := strings.TrimSpace(e.Body); body != "" {
+			msg += "\n" + body
+		}
+		return msg
+	}
+	parts := make([]string, 0, 2)
+	for _, part := range []string{e.Title, e.Detail} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	msg += "\n" + strings.Join(parts, ": ")
+	if e.Name != "" {
+		msg += fmt.Sprintf(" (property: %s)", e.Name)
+	}
+	if e.locked() {
+		msg += "\n" + ErrSectionLocked.Error()
+	}
+	return msg
+}
+
+// Unwrap returns ErrSectionLocked for locked configuration sections
+func (e *APIError) Unwrap() error {
+	if e.locked() {
+		return ErrSectionLocked
+	}
+	return nil
+}
+
+// locked checks if the write was rejected because the configuration section
+// is locked, IIS reports it as forbidden with a problem mentioning the lock
+func (e *APIError) locked() bool {
+	problem := strings.ToLower(e.Title + " " + e.Detail)
+	return e.StatusCode == http.StatusForbidden && strings.Contains(problem, "locked")
+}
+
+// hasStatusCode checks if err is an APIError with the given status code
+func hasStatusCode(err error, statusCode int) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == statusCode
+	}
+	return false
+}
+
+// IsConflictError checks if an error is a 409 Conflict error
+func IsConflictError(err error) bool {
+	return hasStatusCode(err, http.StatusConflict)
+}
+
+// IsNotFoundError checks if an error is a 404 Not Found error
+func IsNotFoundError(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsLockedError checks if an error is caused by a configuration section which
+// is locked at a parent scope
+func IsLockedError(err error) bool {
+	return errors.Is(err, ErrSectionLocked)
+}
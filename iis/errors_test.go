@@ -0,0 +1,70 @@
+package iis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIError_problemDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"title":"Conflict","detail":"Already exists","name":"name","status":409}`)
+	}))
+	defer server.Close()
+
+	client := Client{Host: server.URL}
+	_, err := httpPost(context.Background(), client, "/api/webserver/application-pools", nil)
+
+	var apiErr *APIError
+	if !errors.As(fmt.Errorf("wrapped: %w", err), &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Method != "POST" || apiErr.StatusCode != http.StatusConflict {
+		t.Errorf("unexpected method/status: %s %d", apiErr.Method, apiErr.StatusCode)
+	}
+	if apiErr.Title != "Conflict" || apiErr.Detail != "Already exists" || apiErr.Name != "name" {
+		t.Errorf("unexpected problem details: %+v", apiErr)
+	}
+	if !IsConflictError(err) || IsNotFoundError(err) {
+		t.Errorf("expected conflict error only, got %v", err)
+	}
+}
+
+func TestIsNotFoundError_ignoresMessageContent(t *testing.T) {
+	err := errors.New(`GET /api/files/abc returned "C:\inetpub\404\site"`)
+	if IsNotFoundError(err) {
+		t.Errorf("plain error mentioning 404 must not be treated as not found")
+	}
+	apiErr := &APIError{StatusCode: http.StatusNotFound, Status: "404 Not Found"}
+	if !IsNotFoundError(apiErr) {
+		t.Errorf("expected APIError with 404 to be not found")
+	}
+	if !strings.Contains(apiErr.Error(), "404 Not Found") {
+		t.Errorf("expected status in error message, got %q", apiErr.Error())
+	}
+}
+
+func TestIsLockedError(t *testing.T) {
+	apiErr := &APIError{
+		StatusCode: http.StatusForbidden,
+		Status:     "403 Forbidden",
+		Title:      "Object is locked",
+		Detail:     "This configuration section cannot be used at this path",
+	}
+	if !IsLockedError(fmt.Errorf("wrapped: %w", apiErr)) || !errors.Is(apiErr, ErrSectionLocked) {
+		t.Errorf("expected locked error, got %v", apiErr)
+	}
+	if !strings.Contains(apiErr.Error(), ErrSectionLocked.Error()) {
+		t.Errorf("expected lock explanation in error message, got %q", apiErr.Error())
+	}
+	forbidden := &APIError{StatusCode: http.StatusForbidden, Status: "403 Forbidden", Title: "Forbidden"}
+	if IsLockedError(forbidden) {
+		t.Errorf("forbidden error without lock must not be treated as locked")
+	}
+}
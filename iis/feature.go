@@ -0,0 +1,106 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Scope identifies the website or application a feature is configured for,
+// the server level is used if both ids are empty
+type Scope struct {
+	WebsiteID     string
+	ApplicationID string
+}
+
+func (scope Scope) query() string {
+	switch {
+	case scope.ApplicationID != "":
+		return "webapp.id=" + url.QueryEscape(scope.ApplicationID)
+	case scope.WebsiteID != "":
+		return "website.id=" + url.QueryEscape(scope.WebsiteID)
+	}
+	return ""
+}
+
+// featurePath appends the scope query to the url of a feature
+func featurePath(path string, scope Scope) string {
+	if query := scope.query(); query != "" {
+		return path + "?" + query
+	}
+	return path
+}
+
+// Feature holds the fields shared by all configuration features (sections)
+type Feature struct {
+	ID       string                `json:"id,omitempty"`
+	Scope    string                `json:"scope,omitempty"`
+	Metadata *FeatureMetadata      `json:"metadata,omitempty"`
+	Website  *ApplicationReference `json:"website,omitempty"`
+}
+
+func (feature *Feature) feature() *Feature {
+	return feature
+}
+
+// FeatureMetadata describes where a feature is configured and whether it can
+// be changed at its scope
+type FeatureMetadata struct {
+	IsLocal               bool   `json:"is_local"`
+	IsLocked              bool   `json:"is_locked"`
+	OverrideMode          string `json:"override_mode,omitempty"`
+	OverrideModeEffective string `json:"override_mode_effective,omitempty"`
+}
+
+// deleteFeature removes the local configuration of a feature, so the
+// settings are inherited from the parent scope again
+func deleteFeature(ctx context.Context, client Client, path, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", path, id))
+}
+
+// listFeatureEntries returns the entries of a feature collection, e.g. the
+// file extensions of the request filtering, from the listKey field of the response
+func listFeatureEntries[T any](ctx context.Context, client Client, path, listKey, param, featureID string) ([]T, error) {
+	var res map[string][]T
+	if err := getJson(ctx, client, path+"?"+param+"="+url.QueryEscape(featureID), &res); err != nil {
+		return nil, err
+	}
+	return res[listKey], nil
+}
+
+func createFeatureEntry[T any](ctx context.Context, client Client, path string, entry T) (*T, error) {
+	res, err := httpPost(ctx, client, path, entry)
+	if err != nil {
+		return nil, err
+	}
+	var created T
+	if err := json.Unmarshal(res, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func updateFeatureEntry[T any](ctx context.Context, client Client, path, id string, entry T) (*T, error) {
+	res, err := httpPatch(ctx, client, fmt.Sprintf("%s/%s", path, id), entry)
+	if err != nil {
+		return nil, err
+	}
+	var updated T
+	if err := json.Unmarshal(res, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// updateFeature patches the settings of a feature, the id and scope are part
+// of the url and are not sent with the settings
+func updateFeature[T any, P interface {
+	*T
+	feature() *Feature
+}](ctx context.Context, client Client, path string, settings T) (*T, error) {
+	feature := P(&settings).feature()
+	id := feature.ID
+	*feature = Feature{}
+	return updateFeatureEntry(ctx, client, path, id, settings)
+}
@@ -0,0 +1,51 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// featureDelegationPath returns the url of a feature by its path below
+// /api/webserver, e.g. "ip-restrictions" or "http-request-filtering"
+func featureDelegationPath(feature string) string {
+	return "/api/webserver/" + feature
+}
+
+// ReadFeatureDelegation returns the id, scope and metadata of a feature at a scope
+func (client Client) ReadFeatureDelegation(ctx context.Context, feature string, scope Scope) (*Feature, error) {
+	var settings Feature
+	if err := getJson(ctx, client, featurePath(featureDelegationPath(feature), scope), &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (client Client) ReadFeatureDelegationByID(ctx context.Context, feature, id string) (*Feature, error) {
+	url := fmt.Sprintf("%s/%s", featureDelegationPath(feature), id)
+	var settings Feature
+	if err := getJson(ctx, client, url, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// UpdateFeatureOverrideMode sets the override mode of a feature, allow and
+// deny decide whether the feature can be configured below its scope, inherit
+// uses the mode of the parent scope
+func (client Client) UpdateFeatureOverrideMode(ctx context.Context, feature, id, overrideMode string) (*Feature, error) {
+	url := fmt.Sprintf("%s/%s", featureDelegationPath(feature), id)
+	// Only the override mode is sent, the settings of the feature are kept
+	body := map[string]interface{}{
+		"metadata": map[string]string{"override_mode": overrideMode},
+	}
+	res, err := httpPatch(ctx, client, url, body)
+	if err != nil {
+		return nil, err
+	}
+	var settings Feature
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
@@ -0,0 +1,82 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	httpHandlersPath       = "/api/webserver/http-handlers"
+	handlerMappingsPath    = httpHandlersPath + "/entries"
+	httpHandlersQueryParam = "http_handler.id"
+)
+
+// HttpHandlers holds the handler mappings of a scope
+type HttpHandlers struct {
+	Feature
+}
+
+// HandlerMapping maps requests matching a path and verbs to a module or
+// managed handler. The first matching mapping handles the request, the
+// priority is the zero-based position of the mapping in its scope.
+type HandlerMapping struct {
+	Name                string     `json:"name"`
+	ID                  string     `json:"id,omitempty"`
+	Priority            *int       `json:"priority,omitempty"`
+	Path                string     `json:"path"`
+	Verbs               string     `json:"verbs"`
+	Type                string     `json:"type"`
+	Modules             string     `json:"modules"`
+	ScriptProcessor     string     `json:"script_processor"`
+	ResourceType        string     `json:"resource_type"`
+	RequireAccess       string     `json:"require_access"`
+	AllowPathInfo       bool       `json:"allow_path_info"`
+	Precondition        string     `json:"precondition"`
+	ResponseBufferLimit int64      `json:"response_buffer_limit,omitempty"`
+	HttpHandler         *Reference `json:"http_handler,omitempty"`
+}
+
+func (client Client) ReadHttpHandlers(ctx context.Context, scope Scope) (*HttpHandlers, error) {
+	var handlers HttpHandlers
+	if err := getJson(ctx, client, featurePath(httpHandlersPath, scope), &handlers); err != nil {
+		return nil, err
+	}
+	return &handlers, nil
+}
+
+func (client Client) ReadHttpHandlersByID(ctx context.Context, id string) (*HttpHandlers, error) {
+	url := fmt.Sprintf("%s/%s", httpHandlersPath, id)
+	var handlers HttpHandlers
+	if err := getJson(ctx, client, url, &handlers); err != nil {
+		return nil, err
+	}
+	return &handlers, nil
+}
+
+func (client Client) ListHandlerMappings(ctx context.Context, httpHandlersID string) ([]HandlerMapping, error) {
+	return listFeatureEntries[HandlerMapping](ctx, client, handlerMappingsPath, "entries", httpHandlersQueryParam, httpHandlersID)
+}
+
+func (client Client) ReadHandlerMapping(ctx context.Context, id string) (*HandlerMapping, error) {
+	var mapping HandlerMapping
+	if err := getJson(ctx, client, fmt.Sprintf("%s/%s", handlerMappingsPath, id), &mapping); err != nil {
+		return nil, err
+	}
+	return &mapping, nil
+}
+
+// CreateHandlerMapping adds a mapping in front of the inherited mappings of
+// the scope if no priority is set
+func (client Client) CreateHandlerMapping(ctx context.Context, httpHandlersID string, mapping HandlerMapping) (*HandlerMapping, error) {
+	mapping.HttpHandler = &Reference{ID: httpHandlersID}
+	return createFeatureEntry(ctx, client, handlerMappingsPath, mapping)
+}
+
+// UpdateHandlerMapping changes a mapping, setting the priority moves it within the scope
+func (client Client) UpdateHandlerMapping(ctx context.Context, id string, mapping HandlerMapping) (*HandlerMapping, error) {
+	return updateFeatureEntry(ctx, client, handlerMappingsPath, id, mapping)
+}
+
+func (client Client) DeleteHandlerMapping(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", handlerMappingsPath, id))
+}
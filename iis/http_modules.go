@@ -0,0 +1,70 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	httpModulesPath       = "/api/webserver/http-modules"
+	modulesPath           = httpModulesPath + "/entries"
+	httpModulesQueryParam = "http_module.id"
+)
+
+// HttpModules holds the modules enabled for a scope
+type HttpModules struct {
+	Feature
+	RunAllManagedModulesForAllRequests bool `json:"run_all_managed_modules_for_all_requests"`
+}
+
+// Module is a native module, which has to be installed as global module, or
+// a managed module identified by its type
+type Module struct {
+	Name         string     `json:"name"`
+	ID           string     `json:"id,omitempty"`
+	Type         string     `json:"type"`
+	Precondition string     `json:"precondition"`
+	HttpModule   *Reference `json:"http_module,omitempty"`
+}
+
+func (client Client) ReadHttpModules(ctx context.Context, scope Scope) (*HttpModules, error) {
+	var modules HttpModules
+	if err := getJson(ctx, client, featurePath(httpModulesPath, scope), &modules); err != nil {
+		return nil, err
+	}
+	return &modules, nil
+}
+
+func (client Client) ReadHttpModulesByID(ctx context.Context, id string) (*HttpModules, error) {
+	url := fmt.Sprintf("%s/%s", httpModulesPath, id)
+	var modules HttpModules
+	if err := getJson(ctx, client, url, &modules); err != nil {
+		return nil, err
+	}
+	return &modules, nil
+}
+
+func (client Client) ListModules(ctx context.Context, httpModulesID string) ([]Module, error) {
+	return listFeatureEntries[Module](ctx, client, modulesPath, "entries", httpModulesQueryParam, httpModulesID)
+}
+
+func (client Client) ReadModule(ctx context.Context, id string) (*Module, error) {
+	var module Module
+	if err := getJson(ctx, client, fmt.Sprintf("%s/%s", modulesPath, id), &module); err != nil {
+		return nil, err
+	}
+	return &module, nil
+}
+
+func (client Client) CreateModule(ctx context.Context, httpModulesID string, module Module) (*Module, error) {
+	module.HttpModule = &Reference{ID: httpModulesID}
+	return createFeatureEntry(ctx, client, modulesPath, module)
+}
+
+func (client Client) UpdateModule(ctx context.Context, id string, module Module) (*Module, error) {
+	return updateFeatureEntry(ctx, client, modulesPath, id, module)
+}
+
+func (client Client) DeleteModule(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", modulesPath, id))
+}
@@ -0,0 +1,78 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ResponseHeaderKind is the collection of a response header
+type ResponseHeaderKind string
+
+const (
+	// CustomHeaders are added to every response
+	CustomHeaders ResponseHeaderKind = "custom_headers"
+	// RedirectHeaders are only added to redirect responses
+	RedirectHeaders ResponseHeaderKind = "redirect_headers"
+)
+
+func (kind ResponseHeaderKind) path() string {
+	switch kind {
+	case RedirectHeaders:
+		return httpResponseHeadersPath + "/redirect-headers"
+	default:
+		return httpResponseHeadersPath + "/custom-headers"
+	}
+}
+
+type ResponseHeader struct {
+	Name                string     `json:"name"`
+	Value               string     `json:"value"`
+	ID                  string     `json:"id,omitempty"`
+	HttpResponseHeaders *Reference `json:"http_response_headers,omitempty"`
+}
+
+func (client Client) ListResponseHeaders(ctx context.Context, kind ResponseHeaderKind, httpResponseHeadersID string) ([]ResponseHeader, error) {
+	var res map[ResponseHeaderKind][]ResponseHeader
+	path := kind.path() + "?http_response_headers.id=" + url.QueryEscape(httpResponseHeadersID)
+	if err := getJson(ctx, client, path, &res); err != nil {
+		return nil, err
+	}
+	return res[kind], nil
+}
+
+func (client Client) CreateResponseHeader(ctx context.Context, kind ResponseHeaderKind, httpResponseHeadersID, name, value string) (*ResponseHeader, error) {
+	request := ResponseHeader{Name: name, Value: value, HttpResponseHeaders: &Reference{ID: httpResponseHeadersID}}
+	res, err := httpPost(ctx, client, kind.path(), request)
+	if err != nil {
+		return nil, err
+	}
+	var header ResponseHeader
+	if err := json.Unmarshal(res, &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+func (client Client) UpdateResponseHeader(ctx context.Context, kind ResponseHeaderKind, id, value string) (*ResponseHeader, error) {
+	url := fmt.Sprintf("%s/%s", kind.path(), id)
+	res, err := httpPatch(ctx, client, url, UpdateResponseHeaderRequest{Value: value})
+	if err != nil {
+		return nil, err
+	}
+	var header ResponseHeader
+	if err := json.Unmarshal(res, &header); err != nil {
+		return nil, err
+	}
+	return &header, nil
+}
+
+func (client Client) DeleteResponseHeader(ctx context.Context, kind ResponseHeaderKind, id string) error {
+	url := fmt.Sprintf("%s/%s", kind.path(), id)
+	return httpDelete(ctx, client, url)
+}
+
+type UpdateResponseHeaderRequest struct {
+	Value string `json:"value"`
+}
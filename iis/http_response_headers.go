@@ -0,0 +1,36 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const httpResponseHeadersPath = "/api/webserver/http-response-headers"
+
+type HttpResponseHeaders struct {
+	Feature
+	AllowKeepAlive bool `json:"allow_keep_alive"`
+}
+
+func (client Client) ReadHttpResponseHeaders(ctx context.Context, scope Scope) (*HttpResponseHeaders, error) {
+	var headers HttpResponseHeaders
+	if err := getJson(ctx, client, featurePath(httpResponseHeadersPath, scope), &headers); err != nil {
+		return nil, err
+	}
+	return &headers, nil
+}
+
+func (client Client) ReadHttpResponseHeadersByID(ctx context.Context, id string) (*HttpResponseHeaders, error) {
+	url := fmt.Sprintf("%s/%s", httpResponseHeadersPath, id)
+	var headers HttpResponseHeaders
+	if err := getJson(ctx, client, url, &headers); err != nil {
+		return nil, err
+	}
+	return &headers, nil
+}
+
+// DeleteHttpResponseHeaders removes the local response header configuration
+// of its scope, so the headers are inherited again
+func (client Client) DeleteHttpResponseHeaders(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, httpResponseHeadersPath, id)
+}
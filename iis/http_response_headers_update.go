@@ -0,0 +1,24 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (client Client) UpdateHttpResponseHeaders(ctx context.Context, id string, request UpdateHttpResponseHeadersRequest) (*HttpResponseHeaders, error) {
+	url := fmt.Sprintf("%s/%s", httpResponseHeadersPath, id)
+	res, err := httpPatch(ctx, client, url, request)
+	if err != nil {
+		return nil, err
+	}
+	var headers HttpResponseHeaders
+	if err := json.Unmarshal(res, &headers); err != nil {
+		return nil, err
+	}
+	return &headers, nil
+}
+
+type UpdateHttpResponseHeadersRequest struct {
+	AllowKeepAlive bool `json:"allow_keep_alive"`
+}
@@ -0,0 +1,56 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const xsrfHeader = "XSRF-TOKEN"
+
+func (s *Server) registerApiKeys(mux *http.ServeMux) {
+	mux.HandleFunc("GET /security/api-keys", s.getApiKeys)
+	mux.HandleFunc("POST /security/api-keys", s.createApiKey)
+}
+
+// getApiKeys issues the XSRF token required to create an api key
+func (s *Server) getApiKeys(w http.ResponseWriter, r *http.Request) {
+	if _, _, ok := r.BasicAuth(); !ok {
+		writeProblem(w, http.StatusUnauthorized, "Unauthorized", "windows authentication required", "")
+		return
+	}
+	token := newID()
+	s.mu.Lock()
+	s.xsrfTokens[token] = true
+	s.mu.Unlock()
+
+	w.Header().Set(xsrfHeader, token)
+	http.SetCookie(w, &http.Cookie{Name: xsrfHeader, Value: token, Path: "/"})
+	writeJSON(w, http.StatusOK, map[string]interface{}{"api_keys": []interface{}{}})
+}
+
+func (s *Server) createApiKey(w http.ResponseWriter, r *http.Request) {
+	token := r.Header.Get(xsrfHeader)
+	cookie, err := r.Cookie(xsrfHeader)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if token == "" || !s.xsrfTokens[token] || err != nil || cookie.Value != token {
+		writeProblem(w, http.StatusForbidden, "Forbidden", "invalid XSRF token", "")
+		return
+	}
+	delete(s.xsrfTokens, token)
+
+	var req iis.ApiTokenRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	// IIS Administration API keys are 54 characters long
+	accessToken := (newID() + newID() + newID())[:54]
+	s.apiKeys[accessToken] = true
+	writeJSON(w, http.StatusCreated, iis.ApiTokenResponse{
+		AccessToken: accessToken,
+		ID:          newID(),
+		ExpiresOn:   req.ExpiresOn,
+	})
+}
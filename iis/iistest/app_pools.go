@@ -0,0 +1,164 @@
+package iistest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func (s *Server) registerAppPools(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/webserver/application-pools", s.listAppPools)
+	mux.HandleFunc("POST /api/webserver/application-pools", s.createAppPool)
+	mux.HandleFunc("GET /api/webserver/application-pools/{id}", s.getAppPool)
+	mux.HandleFunc("PATCH /api/webserver/application-pools/{id}", s.updateAppPool)
+	mux.HandleFunc("DELETE /api/webserver/application-pools/{id}", s.deleteAppPool)
+}
+
+// defaultAppPool mirrors the settings IIS applies to a new application pool
+func defaultAppPool() iis.ApplicationPool {
+	return iis.ApplicationPool{
+		Status:                "started",
+		AutoStart:             true,
+		StartMode:             "OnDemand",
+		PipelineMode:          "integrated",
+		ManagedRuntimeVersion: "v4.0",
+		QueueLength:           1000,
+		CPU: iis.CPU{
+			LimitInterval:           iis.Minutes(5 * time.Minute),
+			Action:                  "NoAction",
+			ProcessorAffinityMask32: "0xFFFFFFFF",
+			ProcessorAffinityMask64: "0xFFFFFFFF",
+		},
+		ProcessModel: iis.ProcessModel{
+			IdleTimeout:       iis.Minutes(20 * time.Minute),
+			MaxProcesses:      1,
+			PingingEnabled:    true,
+			PingInterval:      iis.Seconds(30 * time.Second),
+			PingResponseTime:  iis.Seconds(90 * time.Second),
+			ShutdownTimeLimit: iis.Seconds(90 * time.Second),
+			StartupTimeLimit:  iis.Seconds(90 * time.Second),
+			IdleTimeoutAction: "Terminate",
+		},
+		Identity: iis.Identity{
+			IdentityType:    "ApplicationPoolIdentity",
+			LoadUserProfile: true,
+		},
+		Recycling: iis.Recycling{
+			LogEvents: iis.LogEvents{
+				Time:          true,
+				Memory:        true,
+				PrivateMemory: true,
+			},
+			PeriodicRestart: iis.PeriodicRestart{
+				TimeInterval: iis.Minutes(29 * time.Hour),
+				Schedule:     []iis.TimeOfDay{},
+			},
+		},
+		RapidFailProtection: iis.RapidFailProtection{
+			Enabled:                  true,
+			LoadBalancerCapabilities: "HttpLevel",
+			Interval:                 iis.Minutes(5 * time.Minute),
+			MaxCrashes:               5,
+		},
+	}
+}
+
+// AppPoolPassword returns the password of the identity of an application pool,
+// which is not part of any API response
+func (s *Server) AppPoolPassword(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if pool, ok := s.appPools[id]; ok {
+		return pool.Identity.Password
+	}
+	return ""
+}
+
+func (s *Server) renderAppPool(pool *iis.ApplicationPool) map[string]interface{} {
+	rendered := *pool
+	rendered.Identity.Password = ""
+	return withLinks(rendered, map[string]string{
+		"webapps":  "/api/webserver/webapps?application_pool.id=" + pool.ID,
+		"websites": "/api/webserver/websites?application_pool.id=" + pool.ID,
+	})
+}
+
+func (s *Server) listAppPools(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pools := make([]interface{}, 0, len(s.appPools))
+	for _, pool := range s.appPools {
+		pools = append(pools, s.renderAppPool(pool))
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"app_pools": pools})
+}
+
+func (s *Server) getAppPool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pool, ok := s.appPools[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "application_pool")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.renderAppPool(pool))
+}
+
+func (s *Server) createAppPool(w http.ResponseWriter, r *http.Request) {
+	pool := defaultAppPool()
+	if !readJSON(w, r, &pool) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if pool.Name == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "name is required", "name")
+		return
+	}
+	for _, existing := range s.appPools {
+		if existing.Name == pool.Name {
+			writeConflict(w, "name")
+			return
+		}
+	}
+	pool.ID = newID()
+	s.appPools[pool.ID] = &pool
+	writeJSON(w, http.StatusCreated, s.renderAppPool(&pool))
+}
+
+func (s *Server) updateAppPool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pool, ok := s.appPools[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "application_pool")
+		return
+	}
+	updated := *pool
+	schedule := append([]iis.TimeOfDay{}, pool.Recycling.PeriodicRestart.Schedule...)
+	updated.Recycling.PeriodicRestart.Schedule = schedule
+	if !readJSON(w, r, &updated) {
+		return
+	}
+	updated.ID = pool.ID
+	for _, existing := range s.appPools {
+		if existing.ID != pool.ID && existing.Name == updated.Name {
+			writeConflict(w, "name")
+			return
+		}
+	}
+	*pool = updated
+	writeJSON(w, http.StatusOK, s.renderAppPool(pool))
+}
+
+func (s *Server) deleteAppPool(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.appPools[r.PathValue("id")]; !ok {
+		writeNotFound(w, "application_pool")
+		return
+	}
+	delete(s.appPools, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,94 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// authentication holds the authentication settings of a website or webapp
+type authentication struct {
+	anonymous iis.AnonymousAuthentication
+	basic     iis.BasicAuthentication
+	digest    iis.DigestAuthentication
+	windows   iis.WindowsAuthentication
+}
+
+const authenticationPath = "/api/webserver/authentication/"
+
+func (s *Server) registerAuthentication(mux *http.ServeMux) {
+	mux.HandleFunc("GET "+authenticationPath+"{id}", s.getAuthentication)
+	registerAuthenticationFeature(s, mux, "anonymous-authentication", func(auth *authentication) interface{} { return &auth.anonymous })
+	registerAuthenticationFeature(s, mux, "basic-authentication", func(auth *authentication) interface{} { return &auth.basic })
+	registerAuthenticationFeature(s, mux, "digest-authentication", func(auth *authentication) interface{} { return &auth.digest })
+	registerAuthenticationFeature(s, mux, "windows-authentication", func(auth *authentication) interface{} { return &auth.windows })
+}
+
+// newAuthentication creates the authentication settings for a new scope and returns its id
+func (s *Server) newAuthentication() string {
+	id := newID()
+	s.auth[id] = &authentication{
+		anonymous: iis.AnonymousAuthentication{ID: id, Enabled: true, User: "IUSR"},
+		basic:     iis.BasicAuthentication{ID: id},
+		digest:    iis.DigestAuthentication{ID: id},
+		windows: iis.WindowsAuthentication{
+			ID: id,
+			Providers: []iis.WindowsAuthenticationProvider{
+				{Name: "Negotiate", Enabled: true},
+				{Name: "NTLM", Enabled: true},
+			},
+		},
+	}
+	return id
+}
+
+func (s *Server) getAuthentication(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id := r.PathValue("id")
+	if _, ok := s.auth[id]; !ok {
+		writeNotFound(w, "authentication")
+		return
+	}
+	writeJSON(w, http.StatusOK, withLinks(map[string]string{"id": id}, map[string]string{
+		"anonymous": authenticationPath + "anonymous-authentication/" + id,
+		"basic":     authenticationPath + "basic-authentication/" + id,
+		"digest":    authenticationPath + "digest-authentication/" + id,
+		"windows":   authenticationPath + "windows-authentication/" + id,
+	}))
+}
+
+// registerAuthenticationFeature serves GET and PATCH of a single authentication
+// provider, feature returns a pointer to the provider settings of a scope
+func registerAuthenticationFeature(s *Server, mux *http.ServeMux, name string, feature func(*authentication) interface{}) {
+	path := authenticationPath + name + "/{id}"
+	mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		auth, ok := s.auth[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w, name)
+			return
+		}
+		writeJSON(w, http.StatusOK, feature(auth))
+	})
+	mux.HandleFunc("PATCH "+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		id := r.PathValue("id")
+		auth, ok := s.auth[id]
+		if !ok {
+			writeNotFound(w, name)
+			return
+		}
+		updated := *auth
+		updated.windows.Providers = append([]iis.WindowsAuthenticationProvider(nil), auth.windows.Providers...)
+		if !readJSON(w, r, feature(&updated)) {
+			return
+		}
+		// The id is part of the url and cannot be changed
+		updated.anonymous.ID, updated.basic.ID, updated.digest.ID, updated.windows.ID = id, id, id, id
+		*auth = updated
+		writeJSON(w, http.StatusOK, feature(auth))
+	})
+}
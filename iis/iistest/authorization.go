@@ -0,0 +1,45 @@
+package iistest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const authorizationPath = "/api/webserver/authorization"
+
+// authorization holds the URL authorization settings and ordered rules of a scope
+type authorization struct {
+	iis.Authorization
+	Rules []iis.AuthorizationRule
+}
+
+func (s *Server) registerAuthorization(mux *http.ServeMux) {
+	store := newFeatureStore("authorization", func() authorization {
+		return authorization{
+			Rules: []iis.AuthorizationRule{{AccessType: "allow", Users: "*"}},
+		}
+	}, func(authorization *authorization) *iis.Feature { return &authorization.Feature })
+	store.view = func(authorization *authorization) interface{} {
+		return withLinks(authorization.Authorization, map[string]string{
+			"rules": authorizationPath + "/rules?authorization.id=" + authorization.ID,
+		})
+	}
+	store.register(s, mux, authorizationPath)
+	s.authorization = store
+
+	(&featureCollection[authorization, iis.AuthorizationRule]{
+		store: store, name: "rule", listKey: "rules", param: "authorization.id",
+		entries: func(authorization *authorization) *[]iis.AuthorizationRule { return &authorization.Rules },
+		key: func(rule *iis.AuthorizationRule) string {
+			if rule.Users == "" && rule.Roles == "" {
+				return ""
+			}
+			return strings.Join([]string{rule.AccessType, rule.Users, rule.Roles, rule.Verbs}, "|")
+		},
+		fields: func(rule *iis.AuthorizationRule) (*string, **iis.Reference) {
+			return &rule.ID, &rule.Authorization
+		},
+	}).register(s, mux, authorizationPath+"/rules")
+}
@@ -0,0 +1,29 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func (s *Server) registerCertificates(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/certificates", s.listCertificates)
+}
+
+// AddCertificate adds a certificate to the certificate store, an id is generated if empty
+func (s *Server) AddCertificate(certificate iis.Certificate) iis.Certificate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if certificate.ID == "" {
+		certificate.ID = newID()
+	}
+	s.certificates = append(s.certificates, certificate)
+	return certificate
+}
+
+func (s *Server) listCertificates(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	certificates := append([]iis.Certificate{}, s.certificates...)
+	writeJSON(w, http.StatusOK, iis.CertificateListResponse{Certificates: certificates})
+}
@@ -0,0 +1,136 @@
+package iistest
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const defaultDocumentsPath = "/api/webserver/default-documents"
+
+// defaultDocument holds the default document settings and the ordered file names of a scope
+type defaultDocument struct {
+	iis.DefaultDocument
+	Files []string
+}
+
+func (s *Server) registerDefaultDocuments(mux *http.ServeMux) {
+	s.defaultDocuments = newFeatureStore("default_document", func() defaultDocument {
+		return defaultDocument{
+			DefaultDocument: iis.DefaultDocument{Enabled: true},
+			Files:           []string{"Default.htm", "Default.asp", "index.htm", "index.html", "iisstart.htm", "default.aspx"},
+		}
+	}, func(document *defaultDocument) *iis.Feature { return &document.Feature })
+	s.defaultDocuments.view = func(document *defaultDocument) interface{} {
+		return withLinks(document.DefaultDocument, map[string]string{
+			"files": defaultDocumentsPath + "/files?default_document.id=" + document.ID,
+		})
+	}
+	s.defaultDocuments.register(s, mux, defaultDocumentsPath)
+	mux.HandleFunc("GET "+defaultDocumentsPath+"/files", s.listDefaultDocumentFiles)
+	mux.HandleFunc("POST "+defaultDocumentsPath+"/files", s.createDefaultDocumentFile)
+	mux.HandleFunc("GET "+defaultDocumentsPath+"/files/{id}", s.getDefaultDocumentFile)
+	mux.HandleFunc("DELETE "+defaultDocumentsPath+"/files/{id}", s.deleteDefaultDocumentFile)
+}
+
+// defaultDocumentFileID derives the id of a file from its feature and name like IIS does
+func defaultDocumentFileID(documentID, name string) string {
+	return documentID + "-" + hex.EncodeToString([]byte(strings.ToLower(name)))
+}
+
+func renderDefaultDocumentFile(documentID, name string) iis.DefaultDocumentFile {
+	return iis.DefaultDocumentFile{
+		Name:            name,
+		ID:              defaultDocumentFileID(documentID, name),
+		DefaultDocument: &iis.Reference{ID: documentID},
+	}
+}
+
+// lookupDefaultDocumentFile resolves a file id to its feature and index in the file list
+func (s *Server) lookupDefaultDocumentFile(id string) (*defaultDocument, int) {
+	documentID, _, _ := strings.Cut(id, "-")
+	document, ok := s.defaultDocuments.settings[documentID]
+	if !ok {
+		return nil, -1
+	}
+	for i, name := range document.Files {
+		if defaultDocumentFileID(documentID, name) == id {
+			return document, i
+		}
+	}
+	return nil, -1
+}
+
+func (s *Server) listDefaultDocumentFiles(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	documentID := r.URL.Query().Get("default_document.id")
+	document, ok := s.defaultDocuments.settings[documentID]
+	if !ok {
+		writeNotFound(w, "default_document")
+		return
+	}
+	files := make([]iis.DefaultDocumentFile, 0, len(document.Files))
+	for _, name := range document.Files {
+		files = append(files, renderDefaultDocumentFile(documentID, name))
+	}
+	writeJSON(w, http.StatusOK, iis.DefaultDocumentFileListResponse{Files: files})
+}
+
+func (s *Server) getDefaultDocumentFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	document, i := s.lookupDefaultDocumentFile(r.PathValue("id"))
+	if document == nil {
+		writeNotFound(w, "file")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderDefaultDocumentFile(document.ID, document.Files[i]))
+}
+
+// createDefaultDocumentFile adds a file in front of the list, like IIS Manager does
+func (s *Server) createDefaultDocumentFile(w http.ResponseWriter, r *http.Request) {
+	var req iis.DefaultDocumentFile
+	if !readJSON(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.DefaultDocument == nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "default_document is required", "default_document")
+		return
+	}
+	document, ok := s.defaultDocuments.settings[req.DefaultDocument.ID]
+	if !ok {
+		writeNotFound(w, "default_document")
+		return
+	}
+	if req.Name == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "name is required", "name")
+		return
+	}
+	for _, name := range document.Files {
+		if strings.EqualFold(name, req.Name) {
+			writeConflict(w, "name")
+			return
+		}
+	}
+	document.Files = append([]string{req.Name}, document.Files...)
+	document.Metadata.IsLocal = true
+	writeJSON(w, http.StatusCreated, renderDefaultDocumentFile(document.ID, req.Name))
+}
+
+func (s *Server) deleteDefaultDocumentFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	document, i := s.lookupDefaultDocumentFile(r.PathValue("id"))
+	if document == nil {
+		writeNotFound(w, "file")
+		return
+	}
+	document.Files = append(document.Files[:i:i], document.Files[i+1:]...)
+	document.Metadata.IsLocal = true
+	w.WriteHeader(http.StatusNoContent)
+}
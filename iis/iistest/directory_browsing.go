@@ -0,0 +1,17 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func (s *Server) registerDirectoryBrowsing(mux *http.ServeMux) {
+	store := newFeatureStore("directory_browsing", func() iis.DirectoryBrowsing {
+		return iis.DirectoryBrowsing{
+			AllowedAttributes: iis.DirectoryBrowseAttributes{Date: true, Time: true, Size: true, Extension: true},
+		}
+	}, func(browsing *iis.DirectoryBrowsing) *iis.Feature { return &browsing.Feature })
+	store.register(s, mux, "/api/webserver/directory-browsing")
+	s.directoryBrowsing = store
+}
@@ -0,0 +1,460 @@
+package iistest
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// featureScope is the server, a website or an application a feature is configured for
+type featureScope struct {
+	// key identifies the scope, e.g. "website/<id>", the server level has an empty key
+	key     string
+	parent  string
+	feature iis.Feature
+}
+
+// resolveFeatureScope resolves the website.id, webapp.id or scope query of a feature
+// request, writing a not found response for unknown scopes
+func (s *Server) resolveFeatureScope(w http.ResponseWriter, r *http.Request) (featureScope, bool) {
+	query := r.URL.Query()
+	if id := query.Get("webapp.id"); id != "" {
+		app, ok := s.webapps[id]
+		if !ok {
+			writeNotFound(w, "webapp")
+			return featureScope{}, false
+		}
+		return webappFeatureScope(app), true
+	}
+	if query.Has("scope") {
+		return s.resolveScopeQuery(w, strings.TrimSuffix(query.Get("scope"), "/"))
+	}
+	if id := query.Get("website.id"); id != "" {
+		site, ok := s.websites[id]
+		if !ok {
+			writeNotFound(w, "website")
+			return featureScope{}, false
+		}
+		return websiteFeatureScope(site), true
+	}
+	return featureScope{}, true
+}
+
+func websiteFeatureScope(site *website) featureScope {
+	return featureScope{
+		key:     "website/" + site.ID,
+		feature: iis.Feature{Scope: site.Name, Website: &iis.ApplicationReference{Name: site.Name, ID: site.ID, Status: site.Status}},
+	}
+}
+
+func webappFeatureScope(app *webapp) featureScope {
+	return featureScope{
+		key:     "webapp/" + app.ID,
+		parent:  "website/" + app.Website.ID,
+		feature: iis.Feature{Scope: app.Location, Website: &app.Website},
+	}
+}
+
+// resolveScopeQuery resolves a scope query by location, e.g. "site" or
+// "site/app", the server level has an empty location
+func (s *Server) resolveScopeQuery(w http.ResponseWriter, location string) (featureScope, bool) {
+	if location == "" {
+		return featureScope{}, true
+	}
+	for _, site := range s.websites {
+		if site.Name == location {
+			return websiteFeatureScope(site), true
+		}
+	}
+	for _, app := range s.webapps {
+		if app.Location == location {
+			return webappFeatureScope(app), true
+		}
+	}
+	writeNotFound(w, "scope")
+	return featureScope{}, false
+}
+
+// featureStore holds the settings of one feature for all scopes. The settings
+// of a scope are copied from its parent scope when they are first requested,
+// so T must only have exported fields.
+type featureStore[T any] struct {
+	name     string
+	defaults func() T
+	// common returns the fields shared by all features of the settings
+	common func(*T) *iis.Feature
+	// view returns the response body of the settings, the settings are rendered as is if nil
+	view func(*T) interface{}
+	// path is the url of the feature, it is linked as self from the settings
+	path     string
+	ids      map[string]string
+	settings map[string]*T
+	scopes   map[string]featureScope
+}
+
+func newFeatureStore[T any](name string, defaults func() T, common func(*T) *iis.Feature) *featureStore[T] {
+	return &featureStore[T]{
+		name:     name,
+		defaults: defaults,
+		common:   common,
+		ids:      make(map[string]string),
+		settings: make(map[string]*T),
+		scopes:   make(map[string]featureScope),
+	}
+}
+
+// get returns the settings of a scope, creating them if needed
+func (f *featureStore[T]) get(scope featureScope) *T {
+	if id, ok := f.ids[scope.key]; ok {
+		return f.settings[id]
+	}
+	var settings T
+	if scope.key == "" {
+		settings = f.defaults()
+	} else if parentID, ok := f.ids[scope.parent]; ok && scope.parent != "" {
+		settings = clone(*f.settings[parentID])
+	} else {
+		settings = clone(*f.get(featureScope{}))
+	}
+	id := newID()
+	common := f.common(&settings)
+	*common = scope.feature
+	common.ID = id
+	common.Metadata = &iis.FeatureMetadata{OverrideMode: "inherit", OverrideModeEffective: "allow"}
+	f.ids[scope.key] = id
+	f.settings[id] = &settings
+	f.scopes[id] = scope
+	return &settings
+}
+
+// overrideMode returns the effective override mode of a scope, an inherited
+// mode is taken from the nearest parent scope which sets one
+func (f *featureStore[T]) overrideMode(key string) string {
+	id, ok := f.ids[key]
+	if ok {
+		if mode := f.common(f.settings[id]).Metadata.OverrideMode; mode != "" && mode != "inherit" {
+			return mode
+		}
+	}
+	switch {
+	case key == "":
+		return "allow"
+	case ok:
+		return f.overrideMode(f.scopes[id].parent)
+	}
+	return f.overrideMode("")
+}
+
+// locked checks if the settings of a scope cannot be changed, because a
+// parent scope denies overriding them
+func (f *featureStore[T]) locked(scope featureScope) bool {
+	return scope.key != "" && f.overrideMode(scope.parent) == "deny"
+}
+
+func (f *featureStore[T]) render(settings *T) interface{} {
+	common := f.common(settings)
+	scope := f.scopes[common.ID]
+	common.Metadata.OverrideModeEffective = f.overrideMode(scope.key)
+	common.Metadata.IsLocked = f.locked(scope)
+	var rendered interface{} = settings
+	if f.view != nil {
+		rendered = f.view(settings)
+	}
+	return withLinks(rendered, map[string]string{"self": f.path + "/" + common.ID})
+}
+
+// register serves GET by scope and GET, PATCH and DELETE by id of the feature
+func (f *featureStore[T]) register(s *Server, mux *http.ServeMux, path string) {
+	f.path = path
+	mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		scope, ok := s.resolveFeatureScope(w, r)
+		if !ok {
+			return
+		}
+		writeJSON(w, http.StatusOK, f.render(f.get(scope)))
+	})
+	mux.HandleFunc("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		settings, ok := f.settings[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w, f.name)
+			return
+		}
+		writeJSON(w, http.StatusOK, f.render(settings))
+	})
+	mux.HandleFunc("PATCH "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		settings, ok := f.settings[r.PathValue("id")]
+		if !ok {
+			writeNotFound(w, f.name)
+			return
+		}
+		var body map[string]json.RawMessage
+		if !readJSON(w, r, &body) {
+			return
+		}
+		// The metadata can still be changed if the settings are locked
+		if _, ok := body["metadata"]; f.locked(f.scopes[r.PathValue("id")]) && (!ok || len(body) > 1) {
+			writeLocked(w, f.name)
+			return
+		}
+		updated := clone(*settings)
+		common := *f.common(settings)
+		data, _ := json.Marshal(body)
+		if err := json.Unmarshal(data, &updated); err != nil {
+			writeProblem(w, http.StatusBadRequest, "Invalid JSON", err.Error(), "")
+			return
+		}
+		// Only the settings and metadata can be changed, the id and scope are fixed
+		metadata := f.common(&updated).Metadata
+		if metadata == nil {
+			metadata = common.Metadata
+		}
+		metadata.IsLocal = true
+		*f.common(&updated) = common
+		f.common(&updated).Metadata = metadata
+		*settings = updated
+		writeJSON(w, http.StatusOK, f.render(settings))
+	})
+	mux.HandleFunc("DELETE "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		id := r.PathValue("id")
+		scope, ok := f.scopes[id]
+		if !ok {
+			writeNotFound(w, f.name)
+			return
+		}
+		if f.locked(scope) {
+			writeLocked(w, f.name)
+			return
+		}
+		// Deleting the local configuration inherits the settings of the parent
+		// again, the id is kept as IIS derives it from the scope
+		delete(f.ids, scope.key)
+		delete(f.settings, id)
+		delete(f.scopes, id)
+		inherited := f.get(scope)
+		delete(f.settings, f.common(inherited).ID)
+		delete(f.scopes, f.common(inherited).ID)
+		f.common(inherited).ID = id
+		f.ids[scope.key] = id
+		f.settings[id] = inherited
+		f.scopes[id] = scope
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// clone deep copies settings, so updates cannot change the slices of other scopes
+func clone[T any](settings T) T {
+	data, err := json.Marshal(settings)
+	if err != nil {
+		panic(err)
+	}
+	var cloned T
+	if err := json.Unmarshal(data, &cloned); err != nil {
+		panic(err)
+	}
+	return cloned
+}
+
+// featureCollection serves the entries of a feature, e.g. the file extensions
+// of the request filtering. Entries are identified by their feature and key.
+type featureCollection[S any, E any] struct {
+	store *featureStore[S]
+	name  string
+	// listKey is the field of the list response and param the feature id query parameter
+	listKey string
+	param   string
+	entries func(*S) *[]E
+	// key returns the unique key of an entry, compared case-insensitively
+	key func(*E) string
+	// fields returns the id and feature reference fields of an entry
+	fields func(*E) (*string, **iis.Reference)
+	// priority returns the priority field of ordered entries, the priority is
+	// the position of the entry and setting it moves the entry
+	priority func(*E) **int
+	// prepend adds new ordered entries in front instead of at the end if no priority is set
+	prepend bool
+}
+
+func featureEntryID(featureID, key string) string {
+	return featureID + "-" + hex.EncodeToString([]byte(strings.ToLower(key)))
+}
+
+func (c *featureCollection[S, E]) render(featureID string, index int, entry E) E {
+	id, feature := c.fields(&entry)
+	*id = featureEntryID(featureID, c.key(&entry))
+	*feature = &iis.Reference{ID: featureID}
+	if c.priority != nil {
+		*c.priority(&entry) = &index
+	}
+	return entry
+}
+
+// move places the entry at index i at the requested priority, it returns the new index
+func (c *featureCollection[S, E]) move(settings *S, i int) int {
+	entries := *c.entries(settings)
+	if c.priority == nil || *c.priority(&entries[i]) == nil {
+		return i
+	}
+	priority := **c.priority(&entries[i])
+	*c.priority(&entries[i]) = nil
+	priority = max(0, min(priority, len(entries)-1))
+	entry := entries[i]
+	entries = append(entries[:i:i], entries[i+1:]...)
+	*c.entries(settings) = append(entries[:priority:priority], append([]E{entry}, entries[priority:]...)...)
+	return priority
+}
+
+// lookup resolves an entry id to the settings of its feature and the index of the entry
+func (c *featureCollection[S, E]) lookup(id string) (*S, int) {
+	featureID, _, _ := strings.Cut(id, "-")
+	settings, ok := c.store.settings[featureID]
+	if !ok {
+		return nil, -1
+	}
+	for i, entry := range *c.entries(settings) {
+		if featureEntryID(featureID, c.key(&entry)) == id {
+			return settings, i
+		}
+	}
+	return nil, -1
+}
+
+// locked checks if the entries of the feature cannot be changed at its scope
+func (c *featureCollection[S, E]) locked(settings *S) bool {
+	return c.store.locked(c.store.scopes[c.store.common(settings).ID])
+}
+
+func (c *featureCollection[S, E]) conflicts(settings *S, entry *E, except int) bool {
+	for i, existing := range *c.entries(settings) {
+		if i != except && strings.EqualFold(c.key(&existing), c.key(entry)) {
+			return true
+		}
+	}
+	return false
+}
+
+// register serves GET by feature id, POST and GET, PATCH and DELETE by id of the entries
+func (c *featureCollection[S, E]) register(s *Server, mux *http.ServeMux, path string) {
+	mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		featureID := r.URL.Query().Get(c.param)
+		settings, ok := c.store.settings[featureID]
+		if !ok {
+			writeNotFound(w, c.store.name)
+			return
+		}
+		entries := make([]E, 0, len(*c.entries(settings)))
+		for i, entry := range *c.entries(settings) {
+			entries = append(entries, c.render(featureID, i, entry))
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{c.listKey: entries})
+	})
+	mux.HandleFunc("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		var entry E
+		if !readJSON(w, r, &entry) {
+			return
+		}
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		id, feature := c.fields(&entry)
+		if *feature == nil {
+			writeProblem(w, http.StatusBadRequest, "Invalid parameter", c.store.name+" is required", c.store.name)
+			return
+		}
+		featureID := (*feature).ID
+		settings, ok := c.store.settings[featureID]
+		if !ok {
+			writeNotFound(w, c.store.name)
+			return
+		}
+		if c.locked(settings) {
+			writeLocked(w, c.store.name)
+			return
+		}
+		*id, *feature = "", nil
+		if c.key(&entry) == "" {
+			writeProblem(w, http.StatusBadRequest, "Invalid parameter", c.name+" is required", c.name)
+			return
+		}
+		if c.conflicts(settings, &entry, -1) {
+			writeConflict(w, c.name)
+			return
+		}
+		if c.prepend && *c.priority(&entry) == nil {
+			first := 0
+			*c.priority(&entry) = &first
+		}
+		*c.entries(settings) = append(*c.entries(settings), entry)
+		i := c.move(settings, len(*c.entries(settings))-1)
+		c.store.common(settings).Metadata.IsLocal = true
+		writeJSON(w, http.StatusCreated, c.render(featureID, i, (*c.entries(settings))[i]))
+	})
+	mux.HandleFunc("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		settings, i := c.lookup(r.PathValue("id"))
+		if settings == nil {
+			writeNotFound(w, c.name)
+			return
+		}
+		writeJSON(w, http.StatusOK, c.render(c.store.common(settings).ID, i, (*c.entries(settings))[i]))
+	})
+	mux.HandleFunc("PATCH "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		settings, i := c.lookup(r.PathValue("id"))
+		if settings == nil {
+			writeNotFound(w, c.name)
+			return
+		}
+		if c.locked(settings) {
+			writeLocked(w, c.store.name)
+			return
+		}
+		updated := clone((*c.entries(settings))[i])
+		if c.priority != nil {
+			*c.priority(&updated) = nil
+		}
+		if !readJSON(w, r, &updated) {
+			return
+		}
+		id, feature := c.fields(&updated)
+		*id, *feature = "", nil
+		if c.conflicts(settings, &updated, i) {
+			writeConflict(w, c.name)
+			return
+		}
+		(*c.entries(settings))[i] = updated
+		i = c.move(settings, i)
+		c.store.common(settings).Metadata.IsLocal = true
+		writeJSON(w, http.StatusOK, c.render(c.store.common(settings).ID, i, (*c.entries(settings))[i]))
+	})
+	mux.HandleFunc("DELETE "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		settings, i := c.lookup(r.PathValue("id"))
+		if settings == nil {
+			writeNotFound(w, c.name)
+			return
+		}
+		if c.locked(settings) {
+			writeLocked(w, c.store.name)
+			return
+		}
+		entries := *c.entries(settings)
+		*c.entries(settings) = append(entries[:i:i], entries[i+1:]...)
+		c.store.common(settings).Metadata.IsLocal = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,279 @@
+package iistest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func (s *Server) registerFiles(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/files", s.listFiles)
+	mux.HandleFunc("POST /api/files", s.createFile)
+	mux.HandleFunc("GET /api/files/{id}", s.getFile)
+	mux.HandleFunc("DELETE /api/files/{id}", s.deleteFile)
+	mux.HandleFunc("POST /api/files/copy", s.copyFile)
+	mux.HandleFunc("POST /api/files/move", s.moveFile)
+	mux.HandleFunc("GET /api/webserver/files", s.listWebServerFiles)
+	mux.HandleFunc("GET /api/webserver/files/{id}", s.getFile)
+}
+
+// RootDirectory returns the root location of the files API
+func (s *Server) RootDirectory() iis.File {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, file := range s.files {
+		if file.Parent == nil {
+			return *file
+		}
+	}
+	return iis.File{}
+}
+
+// AddFile creates a file or directory below the given parent directory
+func (s *Server) AddFile(parentID, name, fileType string) iis.File {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	parent, ok := s.files[parentID]
+	if !ok {
+		panic("iistest: unknown parent directory " + parentID)
+	}
+	return *s.addFile(parent, name, fileType)
+}
+
+func (s *Server) addRootDirectory(physicalPath string) {
+	now := time.Now().UTC()
+	root := &iis.File{
+		Name:         physicalPath[strings.LastIndex(physicalPath, `\`)+1:],
+		ID:           newID(),
+		Type:         "directory",
+		PhysicalPath: physicalPath,
+		Exists:       true,
+		Created:      now,
+		LastModified: now,
+		LastAccess:   now,
+		Claims:       []string{"read", "write"},
+	}
+	s.files[root.ID] = root
+}
+
+func (s *Server) addFile(parent *iis.File, name, fileType string) *iis.File {
+	now := time.Now().UTC()
+	file := &iis.File{
+		Name:         name,
+		ID:           newID(),
+		Type:         fileType,
+		PhysicalPath: parent.PhysicalPath + `\` + name,
+		Exists:       true,
+		Created:      now,
+		LastModified: now,
+		LastAccess:   now,
+		Parent:       fileRef(parent),
+		Claims:       []string{"read", "write"},
+	}
+	s.files[file.ID] = file
+	return file
+}
+
+func fileRef(file *iis.File) *iis.FileRef {
+	return &iis.FileRef{
+		Name:         file.Name,
+		ID:           file.ID,
+		Type:         file.Type,
+		PhysicalPath: file.PhysicalPath,
+	}
+}
+
+func (s *Server) children(parentID string) []iis.File {
+	files := make([]iis.File, 0)
+	for _, file := range s.files {
+		if (parentID == "" && file.Parent == nil) || (file.Parent != nil && file.Parent.ID == parentID) {
+			file := *file
+			if file.Type == "directory" {
+				file.TotalFiles = len(s.children(file.ID))
+			}
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+func (s *Server) childByName(parentID, name string) *iis.File {
+	for _, file := range s.files {
+		if file.Parent != nil && file.Parent.ID == parentID && strings.EqualFold(file.Name, name) {
+			return file
+		}
+	}
+	return nil
+}
+
+func (s *Server) listFiles(w http.ResponseWriter, r *http.Request) {
+	parentID := r.URL.Query().Get("parent.id")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if parentID != "" {
+		if _, ok := s.files[parentID]; !ok {
+			writeNotFound(w, "parent")
+			return
+		}
+	}
+	writeJSON(w, http.StatusOK, iis.FileListResponse{Files: s.children(parentID)})
+}
+
+// listWebServerFiles lists the files in the root directory of a website
+func (s *Server) listWebServerFiles(w http.ResponseWriter, r *http.Request) {
+	websiteID := r.URL.Query().Get("website.id")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	files := make([]iis.File, 0)
+	if site, ok := s.websites[websiteID]; ok {
+		for _, file := range s.files {
+			if strings.EqualFold(file.PhysicalPath, site.PhysicalPath) {
+				files = s.children(file.ID)
+				break
+			}
+		}
+	} else if websiteID != "" {
+		writeNotFound(w, "website")
+		return
+	}
+	writeJSON(w, http.StatusOK, iis.FileListResponse{Files: files})
+}
+
+func (s *Server) getFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, ok := s.files[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	writeJSON(w, http.StatusOK, file)
+}
+
+func (s *Server) createFile(w http.ResponseWriter, r *http.Request) {
+	var req iis.CreateFileRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	parent := s.directory(w, req.Parent)
+	if parent == nil {
+		return
+	}
+	if req.Name == "" || strings.ContainsAny(req.Name, `\/:*?"<>|`) {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "invalid file name", "name")
+		return
+	}
+	if req.Type != "file" && req.Type != "directory" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "type must be file or directory", "type")
+		return
+	}
+	if s.childByName(parent.ID, req.Name) != nil {
+		writeConflict(w, "name")
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.addFile(parent, req.Name, req.Type))
+}
+
+func (s *Server) deleteFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	file, ok := s.files[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	s.removeFile(file)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) removeFile(file *iis.File) {
+	for _, child := range s.children(file.ID) {
+		s.removeFile(s.files[child.ID])
+	}
+	delete(s.files, file.ID)
+}
+
+func (s *Server) copyFile(w http.ResponseWriter, r *http.Request) {
+	s.copyOrMove(w, r, false)
+}
+
+func (s *Server) moveFile(w http.ResponseWriter, r *http.Request) {
+	s.copyOrMove(w, r, true)
+}
+
+func (s *Server) copyOrMove(w http.ResponseWriter, r *http.Request, move bool) {
+	var req iis.CopyMoveFileRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if req.File == nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "file is required", "file")
+		return
+	}
+	source, ok := s.files[req.File.ID]
+	if !ok {
+		writeNotFound(w, "file")
+		return
+	}
+	parent := s.directory(w, req.Parent)
+	if parent == nil {
+		return
+	}
+	name := req.Name
+	if name == "" {
+		name = source.Name
+	}
+	if s.childByName(parent.ID, name) != nil {
+		writeConflict(w, "name")
+		return
+	}
+	if move {
+		s.relocate(source, parent, name)
+		writeJSON(w, http.StatusCreated, source)
+		return
+	}
+	writeJSON(w, http.StatusCreated, s.copyTree(source, parent, name))
+}
+
+// directory resolves a parent reference, writing an error response if it is not a directory
+func (s *Server) directory(w http.ResponseWriter, ref *iis.FileRef) *iis.File {
+	if ref == nil || ref.ID == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "parent is required", "parent")
+		return nil
+	}
+	parent, ok := s.files[ref.ID]
+	if !ok {
+		writeNotFound(w, "parent")
+		return nil
+	}
+	if parent.Type != "directory" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "parent must be a directory", "parent")
+		return nil
+	}
+	return parent
+}
+
+func (s *Server) copyTree(source, parent *iis.File, name string) *iis.File {
+	copied := s.addFile(parent, name, source.Type)
+	copied.Size = source.Size
+	for _, child := range s.children(source.ID) {
+		s.copyTree(s.files[child.ID], copied, child.Name)
+	}
+	return copied
+}
+
+func (s *Server) relocate(file, parent *iis.File, name string) {
+	file.Name = name
+	file.Parent = fileRef(parent)
+	file.PhysicalPath = parent.PhysicalPath + `\` + name
+	file.LastModified = time.Now().UTC()
+	for _, child := range s.children(file.ID) {
+		s.relocate(s.files[child.ID], file, child.Name)
+	}
+}
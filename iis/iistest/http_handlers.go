@@ -0,0 +1,92 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const (
+	httpHandlersPath = "/api/webserver/http-handlers"
+	httpModulesPath  = "/api/webserver/http-modules"
+)
+
+// httpHandlers holds the ordered handler mappings of a scope
+type httpHandlers struct {
+	iis.HttpHandlers
+	Mappings []iis.HandlerMapping
+}
+
+// httpModules holds the modules of a scope
+type httpModules struct {
+	iis.HttpModules
+	Modules []iis.Module
+}
+
+func (s *Server) registerHttpHandlers(mux *http.ServeMux) {
+	store := newFeatureStore("http_handler", func() httpHandlers {
+		return httpHandlers{
+			Mappings: []iis.HandlerMapping{
+				{
+					Name: "ExtensionlessUrlHandler-Integrated-4.0", Path: "*.", Verbs: "GET,HEAD,POST,DEBUG",
+					Type: "System.Web.Handlers.TransferRequestHandler", ResourceType: "unspecified", RequireAccess: "script",
+					Precondition: "integratedMode,runtimeVersionv4.0", ResponseBufferLimit: 4194304,
+				},
+				{Name: "OPTIONSVerbHandler", Path: "*", Verbs: "OPTIONS", Modules: "ProtocolSupportModule", ResourceType: "unspecified", RequireAccess: "none", ResponseBufferLimit: 4194304},
+				{Name: "TRACEVerbHandler", Path: "*", Verbs: "TRACE", Modules: "ProtocolSupportModule", ResourceType: "unspecified", RequireAccess: "none", ResponseBufferLimit: 4194304},
+				{
+					Name: "StaticFile", Path: "*", Verbs: "*", Modules: "StaticFileModule,DefaultDocumentModule,DirectoryListingModule",
+					ResourceType: "either", RequireAccess: "read", ResponseBufferLimit: 4194304,
+				},
+			},
+		}
+	}, func(handlers *httpHandlers) *iis.Feature { return &handlers.Feature })
+	store.view = func(handlers *httpHandlers) interface{} {
+		return withLinks(handlers.HttpHandlers, map[string]string{
+			"entries": httpHandlersPath + "/entries?http_handler.id=" + handlers.ID,
+		})
+	}
+	store.register(s, mux, httpHandlersPath)
+	s.httpHandlers = store
+
+	// Local handler mappings are added in front of the inherited ones
+	(&featureCollection[httpHandlers, iis.HandlerMapping]{
+		store: store, name: "name", listKey: "entries", param: "http_handler.id",
+		entries: func(handlers *httpHandlers) *[]iis.HandlerMapping { return &handlers.Mappings },
+		key:     func(mapping *iis.HandlerMapping) string { return mapping.Name },
+		fields: func(mapping *iis.HandlerMapping) (*string, **iis.Reference) {
+			return &mapping.ID, &mapping.HttpHandler
+		},
+		priority: func(mapping *iis.HandlerMapping) **int { return &mapping.Priority },
+		prepend:  true,
+	}).register(s, mux, httpHandlersPath+"/entries")
+}
+
+func (s *Server) registerHttpModules(mux *http.ServeMux) {
+	store := newFeatureStore("http_module", func() httpModules {
+		return httpModules{
+			Modules: []iis.Module{
+				{Name: "DefaultDocumentModule"},
+				{Name: "StaticFileModule"},
+				{Name: "AnonymousAuthenticationModule"},
+				{Name: "FormsAuthentication", Type: "System.Web.Security.FormsAuthenticationModule", Precondition: "managedHandler"},
+			},
+		}
+	}, func(modules *httpModules) *iis.Feature { return &modules.Feature })
+	store.view = func(modules *httpModules) interface{} {
+		return withLinks(modules.HttpModules, map[string]string{
+			"entries": httpModulesPath + "/entries?http_module.id=" + modules.ID,
+		})
+	}
+	store.register(s, mux, httpModulesPath)
+	s.httpModules = store
+
+	(&featureCollection[httpModules, iis.Module]{
+		store: store, name: "name", listKey: "entries", param: "http_module.id",
+		entries: func(modules *httpModules) *[]iis.Module { return &modules.Modules },
+		key:     func(module *iis.Module) string { return module.Name },
+		fields: func(module *iis.Module) (*string, **iis.Reference) {
+			return &module.ID, &module.HttpModule
+		},
+	}).register(s, mux, httpModulesPath+"/entries")
+}
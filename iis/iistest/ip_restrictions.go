@@ -0,0 +1,56 @@
+package iistest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const ipRestrictionsPath = "/api/webserver/ip-restrictions"
+
+// ipRestrictions holds the ip restriction settings and rules of a scope
+type ipRestrictions struct {
+	iis.IPRestrictions
+	Rules []iis.IPRestrictionRule
+}
+
+func (s *Server) registerIPRestrictions(mux *http.ServeMux) {
+	store := newFeatureStore("ip_restriction", func() ipRestrictions {
+		return ipRestrictions{
+			IPRestrictions: iis.IPRestrictions{
+				AllowUnlisted: true,
+				DenyAction:    "Forbidden",
+				DenyByConcurrentRequests: iis.DenyByConcurrentRequests{
+					MaxConcurrentRequests: 5,
+				},
+				DenyByRequestRate: iis.DenyByRequestRate{
+					MaxRequests: 20,
+					TimePeriod:  iis.Milliseconds(200 * time.Millisecond),
+				},
+			},
+			Rules: []iis.IPRestrictionRule{},
+		}
+	}, func(restrictions *ipRestrictions) *iis.Feature { return &restrictions.Feature })
+	store.view = func(restrictions *ipRestrictions) interface{} {
+		return withLinks(restrictions.IPRestrictions, map[string]string{
+			"entries": ipRestrictionsPath + "/entries?ip_restriction.id=" + restrictions.ID,
+		})
+	}
+	store.register(s, mux, ipRestrictionsPath)
+	s.ipRestrictions = store
+
+	(&featureCollection[ipRestrictions, iis.IPRestrictionRule]{
+		store: store, name: "ip_address", listKey: "entries", param: "ip_restriction.id",
+		entries: func(restrictions *ipRestrictions) *[]iis.IPRestrictionRule { return &restrictions.Rules },
+		key: func(rule *iis.IPRestrictionRule) string {
+			if rule.IPAddress == "" {
+				return rule.DomainName
+			}
+			return rule.IPAddress + "/" + rule.SubnetMask
+		},
+		fields: func(rule *iis.IPRestrictionRule) (*string, **iis.Reference) {
+			return &rule.ID, &rule.IPRestriction
+		},
+	}).register(s, mux, ipRestrictionsPath+"/entries")
+}
@@ -0,0 +1,32 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func (s *Server) registerLogging(mux *http.ServeMux) {
+	logPerSite := true
+	store := newFeatureStore("logging", func() iis.Logging {
+		return iis.Logging{
+			Enabled:         true,
+			LogPerSite:      &logPerSite,
+			Directory:       `%SystemDrive%\inetpub\logs\LogFiles`,
+			LogFileEncoding: "utf-8",
+			LogFileFormat:   "w3c",
+			LogTarget:       iis.LogTarget{File: true},
+			LogFields: map[string]bool{
+				"date": true, "time": true, "client_ip": true, "username": true, "site_name": false,
+				"computer_name": false, "server_ip": true, "method": true, "uri_stem": true, "uri_query": true,
+				"http_status": true, "win32_status": true, "bytes_sent": false, "bytes_recv": false,
+				"time_taken": true, "server_port": true, "user_agent": true, "cookie": false, "referer": false,
+				"protocol_version": false, "host": false, "http_sub_status": true,
+			},
+			Rollover:        iis.LogRollover{Period: "daily", TruncateSize: 20971520},
+			CustomLogFields: []iis.CustomLogField{},
+		}
+	}, func(logging *iis.Logging) *iis.Feature { return &logging.Feature })
+	store.register(s, mux, "/api/webserver/logging")
+	s.logging = store
+}
@@ -0,0 +1,91 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const requestFilteringPath = "/api/webserver/http-request-filtering"
+
+// requestFiltering holds the request filtering settings and collections of a scope
+type requestFiltering struct {
+	iis.RequestFiltering
+	FileExtensions []iis.FileExtension
+	HiddenSegments []iis.HiddenSegment
+	QueryStrings   []iis.QueryString
+	Rules          []iis.RequestFilteringRule
+}
+
+func (s *Server) registerRequestFiltering(mux *http.ServeMux) {
+	store := newFeatureStore("request_filtering", defaultRequestFiltering,
+		func(filtering *requestFiltering) *iis.Feature { return &filtering.Feature })
+	store.view = func(filtering *requestFiltering) interface{} {
+		query := "?request_filtering.id=" + filtering.ID
+		return withLinks(filtering.RequestFiltering, map[string]string{
+			"file_extensions": requestFilteringPath + "/file-extensions" + query,
+			"hidden_segments": requestFilteringPath + "/hidden-segments" + query,
+			"query_strings":   requestFilteringPath + "/query-strings" + query,
+			"rules":           requestFilteringPath + "/rules" + query,
+		})
+	}
+	store.register(s, mux, requestFilteringPath)
+	s.requestFiltering = store
+
+	(&featureCollection[requestFiltering, iis.FileExtension]{
+		store: store, name: "extension", listKey: "file_extensions", param: "request_filtering.id",
+		entries: func(filtering *requestFiltering) *[]iis.FileExtension { return &filtering.FileExtensions },
+		key:     func(extension *iis.FileExtension) string { return extension.Extension },
+		fields: func(extension *iis.FileExtension) (*string, **iis.Reference) {
+			return &extension.ID, &extension.RequestFiltering
+		},
+	}).register(s, mux, requestFilteringPath+"/file-extensions")
+	(&featureCollection[requestFiltering, iis.HiddenSegment]{
+		store: store, name: "segment", listKey: "hidden_segments", param: "request_filtering.id",
+		entries: func(filtering *requestFiltering) *[]iis.HiddenSegment { return &filtering.HiddenSegments },
+		key:     func(segment *iis.HiddenSegment) string { return segment.Segment },
+		fields: func(segment *iis.HiddenSegment) (*string, **iis.Reference) {
+			return &segment.ID, &segment.RequestFiltering
+		},
+	}).register(s, mux, requestFilteringPath+"/hidden-segments")
+	(&featureCollection[requestFiltering, iis.QueryString]{
+		store: store, name: "query_string", listKey: "query_strings", param: "request_filtering.id",
+		entries: func(filtering *requestFiltering) *[]iis.QueryString { return &filtering.QueryStrings },
+		key:     func(queryString *iis.QueryString) string { return queryString.QueryString },
+		fields: func(queryString *iis.QueryString) (*string, **iis.Reference) {
+			return &queryString.ID, &queryString.RequestFiltering
+		},
+	}).register(s, mux, requestFilteringPath+"/query-strings")
+	(&featureCollection[requestFiltering, iis.RequestFilteringRule]{
+		store: store, name: "name", listKey: "rules", param: "request_filtering.id",
+		entries: func(filtering *requestFiltering) *[]iis.RequestFilteringRule { return &filtering.Rules },
+		key:     func(rule *iis.RequestFilteringRule) string { return rule.Name },
+		fields: func(rule *iis.RequestFilteringRule) (*string, **iis.Reference) {
+			return &rule.ID, &rule.RequestFiltering
+		},
+	}).register(s, mux, requestFilteringPath+"/rules")
+}
+
+// defaultRequestFiltering returns a subset of the server level request filtering of IIS
+func defaultRequestFiltering() requestFiltering {
+	filtering := requestFiltering{
+		RequestFiltering: iis.RequestFiltering{
+			AllowUnlistedFileExtensions: true,
+			AllowUnlistedVerbs:          true,
+			AllowHighBitCharacters:      true,
+			MaxContentLength:            30000000,
+			MaxUrlLength:                4096,
+			MaxQueryStringLength:        2048,
+			Verbs:                       []iis.RequestFilteringVerb{},
+		},
+		QueryStrings: []iis.QueryString{},
+		Rules:        []iis.RequestFilteringRule{},
+	}
+	for _, extension := range []string{".asax", ".ascx", ".master", ".config", ".cs", ".csproj", ".vb", ".mdb", ".mdf", ".ldf"} {
+		filtering.FileExtensions = append(filtering.FileExtensions, iis.FileExtension{Extension: extension})
+	}
+	for _, segment := range []string{"web.config", "bin", "App_code", "App_GlobalResources", "App_LocalResources", "App_WebReferences", "App_Data", "App_Browsers"} {
+		filtering.HiddenSegments = append(filtering.HiddenSegments, iis.HiddenSegment{Segment: segment})
+	}
+	return filtering
+}
@@ -0,0 +1,43 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const requestTracingPath = "/api/webserver/http-request-tracing"
+
+// requestTracing holds the failed request tracing settings and rules of a scope
+type requestTracing struct {
+	iis.RequestTracing
+	Rules []iis.RequestTracingRule
+}
+
+func (s *Server) registerRequestTracing(mux *http.ServeMux) {
+	store := newFeatureStore("request_tracing", func() requestTracing {
+		return requestTracing{
+			RequestTracing: iis.RequestTracing{
+				Directory:               `%SystemDrive%\inetpub\logs\FailedReqLogFiles`,
+				MaximumNumberTraceFiles: 50,
+			},
+			Rules: []iis.RequestTracingRule{},
+		}
+	}, func(tracing *requestTracing) *iis.Feature { return &tracing.Feature })
+	store.view = func(tracing *requestTracing) interface{} {
+		return withLinks(tracing.RequestTracing, map[string]string{
+			"rules": requestTracingPath + "/rules?request_tracing.id=" + tracing.ID,
+		})
+	}
+	store.register(s, mux, requestTracingPath)
+	s.requestTracing = store
+
+	(&featureCollection[requestTracing, iis.RequestTracingRule]{
+		store: store, name: "path", listKey: "rules", param: "request_tracing.id",
+		entries: func(tracing *requestTracing) *[]iis.RequestTracingRule { return &tracing.Rules },
+		key:     func(rule *iis.RequestTracingRule) string { return rule.Path },
+		fields: func(rule *iis.RequestTracingRule) (*string, **iis.Reference) {
+			return &rule.ID, &rule.RequestTracing
+		},
+	}).register(s, mux, requestTracingPath+"/rules")
+}
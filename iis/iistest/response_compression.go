@@ -0,0 +1,42 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func (s *Server) registerResponseCompression(mux *http.ServeMux) {
+	store := newFeatureStore("http_response_compression", func() iis.ResponseCompression {
+		return iis.ResponseCompression{
+			DoStaticCompression:   true,
+			DoDynamicCompression:  true,
+			Directory:             `%SystemDrive%\inetpub\temp\IIS Temporary Compressed Files`,
+			DoDiskSpaceLimiting:   true,
+			MaxDiskSpaceUsage:     100,
+			MinFileSizeToCompress: 2700,
+			Schemes: []iis.CompressionScheme{
+				{Name: "gzip", Dll: `%Windir%\system32\inetsrv\gzip.dll`, DynamicCompressionLevel: 0, StaticCompressionLevel: 7},
+			},
+			DynamicTypes: []iis.CompressionMimeType{
+				{MimeType: "text/*", Enabled: true},
+				{MimeType: "message/*", Enabled: true},
+				{MimeType: "application/x-javascript", Enabled: true},
+				{MimeType: "application/javascript", Enabled: true},
+				{MimeType: "*/*", Enabled: false},
+			},
+			StaticTypes: []iis.CompressionMimeType{
+				{MimeType: "text/*", Enabled: true},
+				{MimeType: "message/*", Enabled: true},
+				{MimeType: "application/x-javascript", Enabled: true},
+				{MimeType: "application/atom+xml", Enabled: true},
+				{MimeType: "application/xaml+xml", Enabled: true},
+				{MimeType: "application/javascript", Enabled: true},
+				{MimeType: "image/svg+xml", Enabled: true},
+				{MimeType: "*/*", Enabled: false},
+			},
+		}
+	}, func(compression *iis.ResponseCompression) *iis.Feature { return &compression.Feature })
+	store.register(s, mux, "/api/webserver/http-response-compression")
+	s.responseCompression = store
+}
@@ -0,0 +1,165 @@
+package iistest
+
+import (
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const httpResponseHeadersPath = "/api/webserver/http-response-headers"
+
+// httpResponseHeaders holds the response header settings and headers of a scope
+type httpResponseHeaders struct {
+	iis.HttpResponseHeaders
+	Headers map[iis.ResponseHeaderKind][]iis.ResponseHeader
+}
+
+func (s *Server) registerHttpResponseHeaders(mux *http.ServeMux) {
+	s.httpResponseHeaders = newFeatureStore("http_response_headers", func() httpResponseHeaders {
+		return httpResponseHeaders{
+			HttpResponseHeaders: iis.HttpResponseHeaders{AllowKeepAlive: true},
+			Headers: map[iis.ResponseHeaderKind][]iis.ResponseHeader{
+				iis.CustomHeaders:   {{Name: "X-Powered-By", Value: "ASP.NET"}},
+				iis.RedirectHeaders: {},
+			},
+		}
+	}, func(headers *httpResponseHeaders) *iis.Feature { return &headers.Feature })
+	s.httpResponseHeaders.view = func(headers *httpResponseHeaders) interface{} {
+		return withLinks(headers.HttpResponseHeaders, map[string]string{
+			"custom_headers":   httpResponseHeadersPath + "/custom-headers?http_response_headers.id=" + headers.ID,
+			"redirect_headers": httpResponseHeadersPath + "/redirect-headers?http_response_headers.id=" + headers.ID,
+		})
+	}
+	s.httpResponseHeaders.register(s, mux, httpResponseHeadersPath)
+	for path, kind := range map[string]iis.ResponseHeaderKind{"/custom-headers": iis.CustomHeaders, "/redirect-headers": iis.RedirectHeaders} {
+		headers := responseHeaderHandlers{s, kind}
+		mux.HandleFunc("GET "+httpResponseHeadersPath+path, headers.list)
+		mux.HandleFunc("POST "+httpResponseHeadersPath+path, headers.create)
+		mux.HandleFunc("GET "+httpResponseHeadersPath+path+"/{id}", headers.get)
+		mux.HandleFunc("PATCH "+httpResponseHeadersPath+path+"/{id}", headers.update)
+		mux.HandleFunc("DELETE "+httpResponseHeadersPath+path+"/{id}", headers.delete)
+	}
+}
+
+// responseHeaderHandlers serves the custom or redirect headers collection
+type responseHeaderHandlers struct {
+	s    *Server
+	kind iis.ResponseHeaderKind
+}
+
+func responseHeaderID(featureID, name string) string {
+	return featureID + "-" + hex.EncodeToString([]byte(strings.ToLower(name)))
+}
+
+func renderResponseHeader(featureID string, header iis.ResponseHeader) iis.ResponseHeader {
+	header.ID = responseHeaderID(featureID, header.Name)
+	header.HttpResponseHeaders = &iis.Reference{ID: featureID}
+	return header
+}
+
+// lookup resolves a header id to its feature and index in the header list
+func (h responseHeaderHandlers) lookup(id string) (*httpResponseHeaders, int) {
+	featureID, _, _ := strings.Cut(id, "-")
+	headers, ok := h.s.httpResponseHeaders.settings[featureID]
+	if !ok {
+		return nil, -1
+	}
+	for i, header := range headers.Headers[h.kind] {
+		if responseHeaderID(featureID, header.Name) == id {
+			return headers, i
+		}
+	}
+	return nil, -1
+}
+
+func (h responseHeaderHandlers) list(w http.ResponseWriter, r *http.Request) {
+	h.s.mu.Lock()
+	defer h.s.mu.Unlock()
+	featureID := r.URL.Query().Get("http_response_headers.id")
+	headers, ok := h.s.httpResponseHeaders.settings[featureID]
+	if !ok {
+		writeNotFound(w, "http_response_headers")
+		return
+	}
+	list := make([]iis.ResponseHeader, 0, len(headers.Headers[h.kind]))
+	for _, header := range headers.Headers[h.kind] {
+		list = append(list, renderResponseHeader(featureID, header))
+	}
+	writeJSON(w, http.StatusOK, map[iis.ResponseHeaderKind]interface{}{h.kind: list})
+}
+
+func (h responseHeaderHandlers) get(w http.ResponseWriter, r *http.Request) {
+	h.s.mu.Lock()
+	defer h.s.mu.Unlock()
+	headers, i := h.lookup(r.PathValue("id"))
+	if headers == nil {
+		writeNotFound(w, "header")
+		return
+	}
+	writeJSON(w, http.StatusOK, renderResponseHeader(headers.ID, headers.Headers[h.kind][i]))
+}
+
+func (h responseHeaderHandlers) create(w http.ResponseWriter, r *http.Request) {
+	var req iis.ResponseHeader
+	if !readJSON(w, r, &req) {
+		return
+	}
+	h.s.mu.Lock()
+	defer h.s.mu.Unlock()
+	if req.HttpResponseHeaders == nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "http_response_headers is required", "http_response_headers")
+		return
+	}
+	headers, ok := h.s.httpResponseHeaders.settings[req.HttpResponseHeaders.ID]
+	if !ok {
+		writeNotFound(w, "http_response_headers")
+		return
+	}
+	if req.Name == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "name is required", "name")
+		return
+	}
+	for _, header := range headers.Headers[h.kind] {
+		if strings.EqualFold(header.Name, req.Name) {
+			writeConflict(w, "name")
+			return
+		}
+	}
+	header := iis.ResponseHeader{Name: req.Name, Value: req.Value}
+	headers.Headers[h.kind] = append(headers.Headers[h.kind], header)
+	headers.Metadata.IsLocal = true
+	writeJSON(w, http.StatusCreated, renderResponseHeader(headers.ID, header))
+}
+
+func (h responseHeaderHandlers) update(w http.ResponseWriter, r *http.Request) {
+	var req iis.UpdateResponseHeaderRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	h.s.mu.Lock()
+	defer h.s.mu.Unlock()
+	headers, i := h.lookup(r.PathValue("id"))
+	if headers == nil {
+		writeNotFound(w, "header")
+		return
+	}
+	headers.Headers[h.kind][i].Value = req.Value
+	headers.Metadata.IsLocal = true
+	writeJSON(w, http.StatusOK, renderResponseHeader(headers.ID, headers.Headers[h.kind][i]))
+}
+
+func (h responseHeaderHandlers) delete(w http.ResponseWriter, r *http.Request) {
+	h.s.mu.Lock()
+	defer h.s.mu.Unlock()
+	headers, i := h.lookup(r.PathValue("id"))
+	if headers == nil {
+		writeNotFound(w, "header")
+		return
+	}
+	list := headers.Headers[h.kind]
+	headers.Headers[h.kind] = append(list[:i:i], list[i+1:]...)
+	headers.Metadata.IsLocal = true
+	w.WriteHeader(http.StatusNoContent)
+}
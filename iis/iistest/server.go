@@ -0,0 +1,265 @@
+// Package iistest provides an in-process fake of the IIS Administration API
+// for tests that cannot reach a Windows host.
+package iistest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// DefaultAccessKey is the access token accepted by a new Server
+const DefaultAccessKey = "iistest-access-key"
+
+// Server emulates the IIS Administration API with in-memory state
+type Server struct {
+	*httptest.Server
+
+	// AccessKey is the access token required on /api requests. Tokens created
+	// through /security/api-keys are accepted as well.
+	AccessKey string
+
+	mu           sync.Mutex
+	faults       []*Fault
+	requests     []string
+	apiKeys      map[string]bool
+	xsrfTokens   map[string]bool
+	websites     map[string]*website
+	appPools     map[string]*iis.ApplicationPool
+	webapps      map[string]*webapp
+	vdirs        map[string]*iis.VirtualDirectory
+	auth         map[string]*authentication
+	files        map[string]*iis.File
+	certificates []iis.Certificate
+
+	defaultDocuments    *featureStore[defaultDocument]
+	httpResponseHeaders *featureStore[httpResponseHeaders]
+	requestFiltering    *featureStore[requestFiltering]
+	ipRestrictions      *featureStore[ipRestrictions]
+	authorization       *featureStore[authorization]
+	responseCompression *featureStore[iis.ResponseCompression]
+	logging             *featureStore[iis.Logging]
+	httpHandlers        *featureStore[httpHandlers]
+	httpModules         *featureStore[httpModules]
+	requestTracing      *featureStore[requestTracing]
+	sslSettings         *featureStore[iis.SSLSettings]
+	staticContent       *featureStore[staticContent]
+	directoryBrowsing   *featureStore[iis.DirectoryBrowsing]
+}
+
+// Fault makes the server fail matching requests instead of handling them
+type Fault struct {
+	// Method to match, empty matches every method
+	Method string
+	// Path prefix to match, empty matches every path
+	Path       string
+	StatusCode int
+	// RetryAfter is sent as Retry-After header if set
+	RetryAfter string
+	// Body is sent as response body, a problem JSON is generated if empty
+	Body string
+	// Count is the number of requests to fail, 0 fails until ClearFaults is called
+	Count int
+}
+
+// NewServer starts a new fake server with a root directory for the files API
+func NewServer() *Server {
+	s := &Server{
+		AccessKey:  DefaultAccessKey,
+		apiKeys:    make(map[string]bool),
+		xsrfTokens: make(map[string]bool),
+		websites:   make(map[string]*website),
+		appPools:   make(map[string]*iis.ApplicationPool),
+		webapps:    make(map[string]*webapp),
+		vdirs:      make(map[string]*iis.VirtualDirectory),
+		auth:       make(map[string]*authentication),
+		files:      make(map[string]*iis.File),
+	}
+	s.addRootDirectory(`C:\inetpub`)
+
+	mux := http.NewServeMux()
+	s.registerApiKeys(mux)
+	s.registerWebsites(mux)
+	s.registerAppPools(mux)
+	s.registerWebapps(mux)
+	s.registerVirtualDirectories(mux)
+	s.registerAuthentication(mux)
+	s.registerFiles(mux)
+	s.registerCertificates(mux)
+	s.registerDefaultDocuments(mux)
+	s.registerHttpResponseHeaders(mux)
+	s.registerRequestFiltering(mux)
+	s.registerIPRestrictions(mux)
+	s.registerAuthorization(mux)
+	s.registerUrlRewrite(mux)
+	s.registerResponseCompression(mux)
+	s.registerLogging(mux)
+	s.registerHttpHandlers(mux)
+	s.registerHttpModules(mux)
+	s.registerRequestTracing(mux)
+	s.registerSSLSettings(mux)
+	s.registerStaticContent(mux)
+	s.registerDirectoryBrowsing(mux)
+
+	s.Server = httptest.NewServer(s.middleware(mux))
+	return s
+}
+
+// Client returns an API client configured for this server
+func (s *Server) Client() *iis.Client {
+	return &iis.Client{
+		Host:      s.URL,
+		AccessKey: s.AccessKey,
+	}
+}
+
+// InjectFault registers a fault for matching requests
+func (s *Server) InjectFault(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes all registered faults
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Requests returns all handled requests formatted as "METHOD /path"
+func (s *Server) Requests() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.requests...)
+}
+
+func (s *Server) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, r.Method+" "+r.URL.Path)
+		fault := s.matchFault(r)
+		authorized := s.authorized(r)
+		s.mu.Unlock()
+
+		if fault != nil {
+			if fault.RetryAfter != "" {
+				w.Header().Set("Retry-After", fault.RetryAfter)
+			}
+			if fault.Body != "" {
+				w.WriteHeader(fault.StatusCode)
+				fmt.Fprint(w, fault.Body)
+				return
+			}
+			writeProblem(w, fault.StatusCode, http.StatusText(fault.StatusCode), "injected fault", "")
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, "/api/") && !authorized {
+			writeProblem(w, http.StatusForbidden, "Forbidden", "missing or invalid access token", "")
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) matchFault(r *http.Request) *Fault {
+	for i, fault := range s.faults {
+		if fault.Method != "" && !strings.EqualFold(fault.Method, r.Method) {
+			continue
+		}
+		if !strings.HasPrefix(r.URL.Path, fault.Path) {
+			continue
+		}
+		if fault.Count > 0 {
+			fault.Count--
+			if fault.Count == 0 {
+				s.faults = append(s.faults[:i], s.faults[i+1:]...)
+			}
+		}
+		return fault
+	}
+	return nil
+}
+
+func (s *Server) authorized(r *http.Request) bool {
+	if s.AccessKey == "" {
+		return true
+	}
+	token := strings.TrimPrefix(r.Header.Get("Access-Token"), "Bearer ")
+	return token == s.AccessKey || s.apiKeys[token]
+}
+
+func newID() string {
+	buffer := make([]byte, 12)
+	if _, err := rand.Read(buffer); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(buffer)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeProblem(w http.ResponseWriter, status int, title, detail, name string) {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"title":  title,
+		"detail": detail,
+		"name":   name,
+		"status": status,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, name string) {
+	writeProblem(w, http.StatusNotFound, "Not found", "", name)
+}
+
+func writeConflict(w http.ResponseWriter, name string) {
+	writeProblem(w, http.StatusConflict, "Conflict", "Already exists", name)
+}
+
+// writeLocked rejects a change of a feature which is locked by the override mode of a parent scope
+func writeLocked(w http.ResponseWriter, name string) {
+	writeProblem(w, http.StatusForbidden, "Object is locked", "This configuration section cannot be used at this path. This happens when the section is locked at a parent level.", name)
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeProblem(w, http.StatusBadRequest, "Invalid JSON", err.Error(), "")
+		return false
+	}
+	return true
+}
+
+// withLinks renders v as JSON object with HAL _links pointing to the given hrefs
+func withLinks(v interface{}, links map[string]string) map[string]interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		panic(err)
+	}
+	// Links of an already rendered object are kept
+	halLinks, ok := object["_links"].(map[string]interface{})
+	if !ok {
+		halLinks = make(map[string]interface{}, len(links))
+	}
+	for name, href := range links {
+		halLinks[name] = map[string]string{"href": href}
+	}
+	object["_links"] = halLinks
+	return object
+}
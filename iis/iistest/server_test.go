@@ -0,0 +1,131 @@
+package iistest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func TestServer_appPoolLifecycle(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	pool, err := client.CreateAppPool(ctx, "test-pool", "v4.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Creating the same pool again resolves the existing one through the 409 response
+	again, err := client.CreateAppPool(ctx, "test-pool", "v4.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != pool.ID {
+		t.Errorf("expected existing pool %s, got %s", pool.ID, again.ID)
+	}
+	status := "stopped"
+	updated, err := client.UpdateAppPool(ctx, pool.ID, iis.UpdateAppPoolRequest{Status: &status})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Status != "stopped" || updated.ManagedRuntimeVersion != "v4.0" {
+		t.Errorf("unexpected pool after update: %+v", updated)
+	}
+	if err := client.DeleteAppPool(ctx, pool.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ReadAppPool(ctx, pool.ID); !iis.IsNotFoundError(err) {
+		t.Errorf("expected not found error, got %v", err)
+	}
+}
+
+func TestServer_applicationAuthentication(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+	ctx := context.Background()
+
+	site, err := client.CreateWebsite(ctx, iis.CreateWebsiteRequest{
+		Name:         "test-site",
+		PhysicalPath: `C:\inetpub\wwwroot`,
+		Bindings:     []iis.WebsiteBinding{{Protocol: "http", Port: 80, IPAddress: "*"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	app, err := client.CreateApplication(ctx, iis.CreateApplicationRequest{
+		Path:         "/app",
+		PhysicalPath: `C:\inetpub\app`,
+		Website:      iis.Reference{ID: site.ID},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth, err := client.ReadAuthenticationFromApplication(ctx, app.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	anonymous, err := client.ReadAnonymousAuthentication(ctx, &auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	anonymous.Enabled = false
+	if _, err := client.UpdateAnonymousAuthentication(ctx, &anonymous); err != nil {
+		t.Fatal(err)
+	}
+	anonymous, err = client.ReadAnonymousAuthentication(ctx, &auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if anonymous.Enabled {
+		t.Error("expected anonymous authentication to be disabled")
+	}
+
+	if err := client.DeleteWebsite(ctx, site.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.ReadApplication(ctx, app.ID); !iis.IsNotFoundError(err) {
+		t.Errorf("expected application to be deleted with its website, got %v", err)
+	}
+}
+
+func TestServer_faultInjection(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+	client.RetryPolicy = &iis.RetryPolicy{MaxAttempts: 3, BaseBackoff: time.Millisecond, Methods: iis.DefaultRetryPolicy().Methods}
+
+	server.InjectFault(Fault{Method: http.MethodGet, Path: "/api/certificates", StatusCode: http.StatusServiceUnavailable, Count: 2})
+	if _, err := client.ListCertificates(context.Background()); err != nil {
+		t.Fatalf("expected request to succeed after retries, got %v", err)
+	}
+	if requests := len(server.Requests()); requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestServer_accessKey(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := server.Client()
+	client.AccessKey = ""
+	ctx := context.Background()
+
+	var apiErr *iis.APIError
+	if _, err := client.ListWebsites(ctx); !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusForbidden {
+		t.Fatalf("expected 403 without access key, got %v", err)
+	}
+	token, err := client.GenerateApiToken(ctx, "administrator", "secret", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.AccessKey = token
+	if _, err := client.ListWebsites(ctx); err != nil {
+		t.Fatalf("expected generated token to be accepted, got %v", err)
+	}
+}
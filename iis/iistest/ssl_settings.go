@@ -0,0 +1,15 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func (s *Server) registerSSLSettings(mux *http.ServeMux) {
+	store := newFeatureStore("ssl_settings", func() iis.SSLSettings {
+		return iis.SSLSettings{ClientCertificates: "ignore"}
+	}, func(settings *iis.SSLSettings) *iis.Feature { return &settings.Feature })
+	store.register(s, mux, "/api/webserver/ssl-settings")
+	s.sslSettings = store
+}
@@ -0,0 +1,56 @@
+package iistest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const staticContentPath = "/api/webserver/static-content"
+
+// staticContent holds the static content settings and mime maps of a scope
+type staticContent struct {
+	iis.StaticContent
+	MimeMaps []iis.MimeMap
+}
+
+func (s *Server) registerStaticContent(mux *http.ServeMux) {
+	store := newFeatureStore("static_content", func() staticContent {
+		return staticContent{
+			StaticContent: iis.StaticContent{
+				ClientCache: iis.ClientCache{
+					MaxAge:      iis.Minutes(24 * time.Hour),
+					ControlMode: "no_control",
+					SetETag:     true,
+					HttpExpires: "Tue, 19 Jan 2038 03:14:07 GMT",
+				},
+			},
+			MimeMaps: []iis.MimeMap{
+				{FileExtension: ".css", MimeType: "text/css"},
+				{FileExtension: ".htm", MimeType: "text/html"},
+				{FileExtension: ".html", MimeType: "text/html"},
+				{FileExtension: ".js", MimeType: "application/javascript"},
+				{FileExtension: ".json", MimeType: "application/json"},
+				{FileExtension: ".png", MimeType: "image/png"},
+				{FileExtension: ".svg", MimeType: "image/svg+xml"},
+			},
+		}
+	}, func(content *staticContent) *iis.Feature { return &content.Feature })
+	store.view = func(content *staticContent) interface{} {
+		return withLinks(content.StaticContent, map[string]string{
+			"mime_maps": staticContentPath + "/mime-maps?static_content.id=" + content.ID,
+		})
+	}
+	store.register(s, mux, staticContentPath)
+	s.staticContent = store
+
+	(&featureCollection[staticContent, iis.MimeMap]{
+		store: store, name: "file_extension", listKey: "mime_maps", param: "static_content.id",
+		entries: func(content *staticContent) *[]iis.MimeMap { return &content.MimeMaps },
+		key:     func(mimeMap *iis.MimeMap) string { return mimeMap.FileExtension },
+		fields: func(mimeMap *iis.MimeMap) (*string, **iis.Reference) {
+			return &mimeMap.ID, &mimeMap.StaticContent
+		},
+	}).register(s, mux, staticContentPath+"/mime-maps")
+}
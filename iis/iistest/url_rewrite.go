@@ -0,0 +1,67 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const urlRewritePath = "/api/webserver/url-rewrite"
+
+// urlRewriteSection holds the rules or maps of a URL Rewrite section of a scope
+type urlRewriteSection[E any] struct {
+	iis.Feature
+	Entries []E
+}
+
+func (s *Server) registerUrlRewrite(mux *http.ServeMux) {
+	registerUrlRewriteSection(s, mux, "inbound", "rules", "inbound_rules", &featureCollection[urlRewriteSection[iis.InboundRule], iis.InboundRule]{
+		name: "name",
+		key:  func(rule *iis.InboundRule) string { return rule.Name },
+		fields: func(rule *iis.InboundRule) (*string, **iis.Reference) {
+			return &rule.ID, &rule.InboundRules
+		},
+		priority: func(rule *iis.InboundRule) **int { return &rule.Priority },
+	})
+	registerUrlRewriteSection(s, mux, "outbound", "rules", "outbound_rules", &featureCollection[urlRewriteSection[iis.OutboundRule], iis.OutboundRule]{
+		name: "name",
+		key:  func(rule *iis.OutboundRule) string { return rule.Name },
+		fields: func(rule *iis.OutboundRule) (*string, **iis.Reference) {
+			return &rule.ID, &rule.OutboundRules
+		},
+		priority: func(rule *iis.OutboundRule) **int { return &rule.Priority },
+	})
+	registerUrlRewriteSection(s, mux, "rewrite-maps", "entries", "rewrite_maps", &featureCollection[urlRewriteSection[iis.RewriteMap], iis.RewriteMap]{
+		name: "name",
+		key:  func(rewriteMap *iis.RewriteMap) string { return rewriteMap.Name },
+		fields: func(rewriteMap *iis.RewriteMap) (*string, **iis.Reference) {
+			return &rewriteMap.ID, &rewriteMap.RewriteMaps
+		},
+	})
+
+	allowedServerVariables := newFeatureStore("allowed_server_variables", func() iis.AllowedServerVariables {
+		return iis.AllowedServerVariables{Entries: []string{}}
+	}, func(variables *iis.AllowedServerVariables) *iis.Feature { return &variables.Feature })
+	allowedServerVariables.register(s, mux, urlRewritePath+"/allowed-server-variables")
+}
+
+// registerUrlRewriteSection serves a section and its rules or maps at
+// <section>/<collection>, which reference the section as parent
+func registerUrlRewriteSection[E any](s *Server, mux *http.ServeMux, section, collection, parent string, entries *featureCollection[urlRewriteSection[E], E]) {
+	path := urlRewritePath + "/" + section
+	store := newFeatureStore(parent, func() urlRewriteSection[E] {
+		return urlRewriteSection[E]{Entries: []E{}}
+	}, func(section *urlRewriteSection[E]) *iis.Feature { return &section.Feature })
+	store.view = func(section *urlRewriteSection[E]) interface{} {
+		return withLinks(section.Feature, map[string]string{
+			collection: path + "/" + collection + "?" + parent + ".id=" + section.ID,
+		})
+	}
+	store.register(s, mux, path)
+
+	entries.store = store
+	entries.listKey = collection
+	entries.param = parent + ".id"
+	entries.entries = func(section *urlRewriteSection[E]) *[]E { return &section.Entries }
+	entries.register(s, mux, path+"/"+collection)
+}
@@ -0,0 +1,172 @@
+package iistest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func (s *Server) registerVirtualDirectories(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/webserver/virtual-directories", s.listVirtualDirectories)
+	mux.HandleFunc("POST /api/webserver/virtual-directories", s.createVirtualDirectory)
+	mux.HandleFunc("GET /api/webserver/virtual-directories/{id}", s.getVirtualDirectory)
+	mux.HandleFunc("PATCH /api/webserver/virtual-directories/{id}", s.updateVirtualDirectory)
+	mux.HandleFunc("DELETE /api/webserver/virtual-directories/{id}", s.deleteVirtualDirectory)
+}
+
+func (s *Server) renderVirtualDirectory(vdir *iis.VirtualDirectory) map[string]interface{} {
+	rendered := *vdir
+	// Like IIS, the password of the connect-as identity is never returned
+	rendered.Identity.Password = ""
+	return withLinks(rendered, map[string]string{
+		"files": "/api/webserver/files?virtual_directory.id=" + vdir.ID,
+	})
+}
+
+func (s *Server) listVirtualDirectories(w http.ResponseWriter, r *http.Request) {
+	websiteID := r.URL.Query().Get("website.id")
+	webappID := r.URL.Query().Get("webapp.id")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	vdirs := make([]interface{}, 0)
+	for _, vdir := range s.vdirs {
+		if (websiteID == "" || vdir.Website.ID == websiteID) && (webappID == "" || vdir.Application.ID == webappID) {
+			vdirs = append(vdirs, s.renderVirtualDirectory(vdir))
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"virtual_directories": vdirs})
+}
+
+func (s *Server) getVirtualDirectory(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	vdir, ok := s.vdirs[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "virtual_directory")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.renderVirtualDirectory(vdir))
+}
+
+func (s *Server) createVirtualDirectory(w http.ResponseWriter, r *http.Request) {
+	var req iis.CreateVirtualDirectoryRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	vdir := &iis.VirtualDirectory{
+		Path:         req.Path,
+		ID:           newID(),
+		PhysicalPath: req.PhysicalPath,
+		Identity:     iis.VirtualDirectoryIdentity{LogonMethod: "network_cleartext"},
+	}
+	switch {
+	case req.Application != nil:
+		app, ok := s.webapps[req.Application.ID]
+		if !ok {
+			writeNotFound(w, "webapp")
+			return
+		}
+		site := s.websites[app.Website.ID]
+		vdir.Website = iis.ApplicationReference{Name: site.Name, ID: site.ID, Status: site.Status}
+		vdir.Application = iis.WebappReference{Location: app.Location, Path: app.Path, ID: app.ID}
+	case req.Website != nil:
+		site, ok := s.websites[req.Website.ID]
+		if !ok {
+			writeNotFound(w, "website")
+			return
+		}
+		vdir.Website = iis.ApplicationReference{Name: site.Name, ID: site.ID, Status: site.Status}
+		vdir.Application = iis.WebappReference{Location: site.Name, Path: "/", ID: site.rootAppID}
+	default:
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "website or webapp is required", "website")
+		return
+	}
+	if !s.validVirtualDirectoryPath(w, vdir.Application.ID, "", req.Path) {
+		return
+	}
+	if req.PhysicalPath == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "physical_path is required", "physical_path")
+		return
+	}
+	if req.Identity != nil {
+		vdir.Identity = *req.Identity
+		if vdir.Identity.LogonMethod == "" {
+			vdir.Identity.LogonMethod = "network_cleartext"
+		}
+	}
+	vdir.Location = virtualDirectoryLocation(vdir)
+	s.vdirs[vdir.ID] = vdir
+	writeJSON(w, http.StatusCreated, s.renderVirtualDirectory(vdir))
+}
+
+func (s *Server) updateVirtualDirectory(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Path         *string                       `json:"path"`
+		PhysicalPath *string                       `json:"physical_path"`
+		Identity     *iis.VirtualDirectoryIdentity `json:"identity"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	vdir, ok := s.vdirs[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "virtual_directory")
+		return
+	}
+	updated := *vdir
+	if req.Path != nil && *req.Path != vdir.Path {
+		if !s.validVirtualDirectoryPath(w, vdir.Application.ID, vdir.ID, *req.Path) {
+			return
+		}
+		updated.Path = *req.Path
+	}
+	if req.PhysicalPath != nil && *req.PhysicalPath != "" {
+		updated.PhysicalPath = *req.PhysicalPath
+	}
+	if req.Identity != nil {
+		updated.Identity.Username = req.Identity.Username
+		if req.Identity.LogonMethod != "" {
+			updated.Identity.LogonMethod = req.Identity.LogonMethod
+		}
+		if req.Identity.Password != "" || req.Identity.Username == "" {
+			updated.Identity.Password = req.Identity.Password
+		}
+	}
+	updated.Location = virtualDirectoryLocation(&updated)
+	*vdir = updated
+	writeJSON(w, http.StatusOK, s.renderVirtualDirectory(vdir))
+}
+
+func (s *Server) deleteVirtualDirectory(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.vdirs[r.PathValue("id")]; !ok {
+		writeNotFound(w, "virtual_directory")
+		return
+	}
+	delete(s.vdirs, r.PathValue("id"))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) validVirtualDirectoryPath(w http.ResponseWriter, webappID, vdirID, path string) bool {
+	if !strings.HasPrefix(path, "/") || path == "/" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "path must start with '/'", "path")
+		return false
+	}
+	for _, existing := range s.vdirs {
+		if existing.ID != vdirID && existing.Application.ID == webappID && strings.EqualFold(existing.Path, path) {
+			writeConflict(w, "path")
+			return false
+		}
+	}
+	return true
+}
+
+func virtualDirectoryLocation(vdir *iis.VirtualDirectory) string {
+	return strings.TrimSuffix(vdir.Application.Location, "/") + vdir.Path
+}
@@ -0,0 +1,171 @@
+package iistest
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+type webapp struct {
+	iis.Application
+	authID string
+}
+
+func (s *Server) registerWebapps(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/webserver/webapps", s.listWebapps)
+	mux.HandleFunc("POST /api/webserver/webapps", s.createWebapp)
+	mux.HandleFunc("GET /api/webserver/webapps/{id}", s.getWebapp)
+	mux.HandleFunc("PATCH /api/webserver/webapps/{id}", s.updateWebapp)
+	mux.HandleFunc("DELETE /api/webserver/webapps/{id}", s.deleteWebapp)
+}
+
+func (s *Server) renderWebapp(app *webapp) map[string]interface{} {
+	return withLinks(app.Application, map[string]string{
+		"authentication": "/api/webserver/authentication/" + app.authID,
+		"files":          "/api/webserver/files?application.id=" + app.ID,
+	})
+}
+
+func (s *Server) listWebapps(w http.ResponseWriter, r *http.Request) {
+	websiteID := r.URL.Query().Get("website.id")
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	webapps := make([]interface{}, 0)
+	for _, app := range s.webapps {
+		if websiteID == "" || app.Website.ID == websiteID {
+			webapps = append(webapps, s.renderWebapp(app))
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"webapps": webapps})
+}
+
+func (s *Server) getWebapp(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	app, ok := s.webapps[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "webapp")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.renderWebapp(app))
+}
+
+func (s *Server) createWebapp(w http.ResponseWriter, r *http.Request) {
+	var req iis.CreateApplicationRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	site, ok := s.websites[req.Website.ID]
+	if !ok {
+		writeNotFound(w, "website")
+		return
+	}
+	if !s.validWebappPath(w, site.ID, "", req.Path) {
+		return
+	}
+	if req.PhysicalPath == "" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "physical_path is required", "physical_path")
+		return
+	}
+	app := &webapp{
+		Application: iis.Application{
+			Location:         site.Name + req.Path,
+			Path:             req.Path,
+			ID:               newID(),
+			PhysicalPath:     req.PhysicalPath,
+			EnabledProtocols: "http",
+			Website:          iis.ApplicationReference{Name: site.Name, ID: site.ID, Status: site.Status},
+			ApplicationPool:  site.ApplicationPool,
+		},
+		authID: s.newAuthentication(),
+	}
+	if !s.resolveWebappAppPool(w, app, req.ApplicationPool.ID) {
+		return
+	}
+	s.webapps[app.ID] = app
+	writeJSON(w, http.StatusCreated, s.renderWebapp(app))
+}
+
+func (s *Server) updateWebapp(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Path            *string        `json:"path"`
+		PhysicalPath    *string        `json:"physical_path"`
+		ApplicationPool *iis.Reference `json:"application_pool"`
+	}
+	if !readJSON(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	app, ok := s.webapps[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "webapp")
+		return
+	}
+	updated := *app
+	if req.Path != nil && *req.Path != app.Path {
+		if !s.validWebappPath(w, app.Website.ID, app.ID, *req.Path) {
+			return
+		}
+		updated.Path = *req.Path
+		updated.Location = app.Website.Name + updated.Path
+	}
+	if req.PhysicalPath != nil && *req.PhysicalPath != "" {
+		updated.PhysicalPath = *req.PhysicalPath
+	}
+	if req.ApplicationPool != nil && req.ApplicationPool.ID != "" {
+		if !s.resolveWebappAppPool(w, &updated, req.ApplicationPool.ID) {
+			return
+		}
+	}
+	*app = updated
+	writeJSON(w, http.StatusOK, s.renderWebapp(app))
+}
+
+func (s *Server) deleteWebapp(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	app, ok := s.webapps[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "webapp")
+		return
+	}
+	for id, vdir := range s.vdirs {
+		if vdir.Application.ID == app.ID {
+			delete(s.vdirs, id)
+		}
+	}
+	delete(s.auth, app.authID)
+	delete(s.webapps, app.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) validWebappPath(w http.ResponseWriter, websiteID, appID, path string) bool {
+	if !strings.HasPrefix(path, "/") || path == "/" {
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "path must start with '/'", "path")
+		return false
+	}
+	for _, existing := range s.webapps {
+		if existing.ID != appID && existing.Website.ID == websiteID && strings.EqualFold(existing.Path, path) {
+			writeConflict(w, "path")
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Server) resolveWebappAppPool(w http.ResponseWriter, app *webapp, id string) bool {
+	if id == "" {
+		return true
+	}
+	pool, ok := s.appPools[id]
+	if !ok {
+		writeNotFound(w, "application_pool")
+		return false
+	}
+	app.ApplicationPool = iis.ApplicationReference{Name: pool.Name, ID: pool.ID, Status: pool.Status}
+	return true
+}
@@ -0,0 +1,163 @@
+package iistest
+
+import (
+	"net/http"
+
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+type website struct {
+	iis.Website
+	authID string
+	// rootAppID is the id of the root application "/" of the website
+	rootAppID string
+}
+
+func (s *Server) registerWebsites(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/webserver/websites", s.listWebsites)
+	mux.HandleFunc("POST /api/webserver/websites", s.createWebsite)
+	mux.HandleFunc("GET /api/webserver/websites/{id}", s.getWebsite)
+	mux.HandleFunc("PATCH /api/webserver/websites/{id}", s.updateWebsite)
+	mux.HandleFunc("DELETE /api/webserver/websites/{id}", s.deleteWebsite)
+}
+
+func (s *Server) renderWebsite(site *website) map[string]interface{} {
+	return withLinks(site.Website, map[string]string{
+		"authentication": "/api/webserver/authentication/" + site.authID,
+		"webapps":        "/api/webserver/webapps?website.id=" + site.ID,
+		"files":          "/api/webserver/files?website.id=" + site.ID,
+	})
+}
+
+func (s *Server) listWebsites(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	websites := make([]interface{}, 0, len(s.websites))
+	for _, site := range s.websites {
+		websites = append(websites, s.renderWebsite(site))
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"websites": websites})
+}
+
+func (s *Server) getWebsite(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	site, ok := s.websites[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "website")
+		return
+	}
+	writeJSON(w, http.StatusOK, s.renderWebsite(site))
+}
+
+func (s *Server) createWebsite(w http.ResponseWriter, r *http.Request) {
+	var req iis.CreateWebsiteRequest
+	if !readJSON(w, r, &req) {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case req.Name == "":
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "name is required", "name")
+		return
+	case req.PhysicalPath == "":
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "physical_path is required", "physical_path")
+		return
+	case len(req.Bindings) == 0:
+		writeProblem(w, http.StatusBadRequest, "Invalid parameter", "at least one binding is required", "bindings")
+		return
+	}
+	for _, existing := range s.websites {
+		if existing.Name == req.Name {
+			writeConflict(w, "name")
+			return
+		}
+	}
+	site := &website{
+		Website: iis.Website{
+			Name:         req.Name,
+			ID:           newID(),
+			Status:       "started",
+			PhysicalPath: req.PhysicalPath,
+			Bindings:     req.Bindings,
+		},
+		authID:    s.newAuthentication(),
+		rootAppID: newID(),
+	}
+	if !s.resolveWebsiteAppPool(w, &site.Website, req.ApplicationPool.ID) {
+		return
+	}
+	s.websites[site.ID] = site
+	writeJSON(w, http.StatusCreated, s.renderWebsite(site))
+}
+
+func (s *Server) updateWebsite(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	site, ok := s.websites[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "website")
+		return
+	}
+	updated := site.Website
+	if !readJSON(w, r, &updated) {
+		return
+	}
+	updated.ID = site.ID
+	for _, existing := range s.websites {
+		if existing.ID != site.ID && existing.Name == updated.Name {
+			writeConflict(w, "name")
+			return
+		}
+	}
+	if !s.resolveWebsiteAppPool(w, &updated, updated.ApplicationPool.ID) {
+		return
+	}
+	site.Website = updated
+	for _, app := range s.webapps {
+		if app.Website.ID == site.ID {
+			app.Website = iis.ApplicationReference{Name: site.Name, ID: site.ID, Status: site.Status}
+			app.Location = site.Name + app.Path
+		}
+	}
+	writeJSON(w, http.StatusOK, s.renderWebsite(site))
+}
+
+func (s *Server) deleteWebsite(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	site, ok := s.websites[r.PathValue("id")]
+	if !ok {
+		writeNotFound(w, "website")
+		return
+	}
+	for id, app := range s.webapps {
+		if app.Website.ID == site.ID {
+			delete(s.auth, app.authID)
+			delete(s.webapps, id)
+		}
+	}
+	for id, vdir := range s.vdirs {
+		if vdir.Website.ID == site.ID {
+			delete(s.vdirs, id)
+		}
+	}
+	delete(s.auth, site.authID)
+	delete(s.websites, site.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) resolveWebsiteAppPool(w http.ResponseWriter, site *iis.Website, id string) bool {
+	if id == "" {
+		site.ApplicationPool = iis.ApplicationReference{}
+		return true
+	}
+	pool, ok := s.appPools[id]
+	if !ok {
+		writeNotFound(w, "application_pool")
+		return false
+	}
+	site.ApplicationPool = iis.ApplicationReference{Name: pool.Name, ID: pool.ID, Status: pool.Status}
+	return true
+}
@@ -0,0 +1,92 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+const ipRestrictionRulesPath = ipRestrictionsPath + "/entries"
+
+// IPRestrictionRule allows or denies an ip address, subnet or domain name
+type IPRestrictionRule struct {
+	Allowed       bool       `json:"allowed"`
+	IPAddress     string     `json:"ip_address,omitempty"`
+	SubnetMask    string     `json:"subnet_mask,omitempty"`
+	DomainName    string     `json:"domain_name,omitempty"`
+	ID            string     `json:"id,omitempty"`
+	IPRestriction *Reference `json:"ip_restriction,omitempty"`
+}
+
+func (client Client) ListIPRestrictionRules(ctx context.Context, ipRestrictionsID string) ([]IPRestrictionRule, error) {
+	return listFeatureEntries[IPRestrictionRule](ctx, client, ipRestrictionRulesPath, "entries", "ip_restriction.id", ipRestrictionsID)
+}
+
+func (client Client) CreateIPRestrictionRule(ctx context.Context, ipRestrictionsID string, rule IPRestrictionRule) (*IPRestrictionRule, error) {
+	rule.IPRestriction = &Reference{ID: ipRestrictionsID}
+	return createFeatureEntry(ctx, client, ipRestrictionRulesPath, rule)
+}
+
+func (client Client) UpdateIPRestrictionRule(ctx context.Context, id string, rule IPRestrictionRule) (*IPRestrictionRule, error) {
+	return updateFeatureEntry(ctx, client, ipRestrictionRulesPath, id, rule)
+}
+
+func (client Client) DeleteIPRestrictionRule(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", ipRestrictionRulesPath, id))
+}
+
+// ParseIPRange converts an address ("10.0.0.1") or CIDR range ("10.0.0.0/8")
+// to the address and subnet mask of a rule. IPv4 masks are written in dotted
+// notation ("255.0.0.0"), IPv6 masks as prefix length.
+func ParseIPRange(value string) (address, mask string, err error) {
+	if !strings.Contains(value, "/") {
+		ip, err := netip.ParseAddr(value)
+		if err != nil {
+			return "", "", err
+		}
+		return ip.String(), "", nil
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return "", "", err
+	}
+	if prefix.Bits() == prefix.Addr().BitLen() {
+		return prefix.Addr().String(), "", nil
+	}
+	if prefix.Addr().Is4() {
+		return prefix.Addr().String(), net.IP(net.CIDRMask(prefix.Bits(), 32)).String(), nil
+	}
+	return prefix.Addr().String(), strconv.Itoa(prefix.Bits()), nil
+}
+
+// FormatIPRange converts the address and subnet mask of a rule to CIDR
+// notation, single addresses are returned without prefix length
+func FormatIPRange(address, mask string) string {
+	ip, err := netip.ParseAddr(address)
+	if err != nil || mask == "" {
+		return address
+	}
+	bits, err := strconv.Atoi(mask)
+	if err != nil {
+		maskIP := net.ParseIP(mask)
+		if maskIP == nil {
+			return address + "/" + mask
+		}
+		if ip.Is4() {
+			maskIP = maskIP.To4()
+		}
+		ones, size := net.IPMask(maskIP).Size()
+		if size == 0 {
+			// The mask is not contiguous and cannot be written as prefix length
+			return address + "/" + mask
+		}
+		bits = ones
+	}
+	if bits == ip.BitLen() {
+		return ip.String()
+	}
+	return netip.PrefixFrom(ip, bits).String()
+}
@@ -0,0 +1,46 @@
+package iis
+
+import "testing"
+
+func TestParseIPRange(t *testing.T) {
+	tests := map[string][2]string{
+		"10.0.0.1":       {"10.0.0.1", ""},
+		"10.0.0.1/32":    {"10.0.0.1", ""},
+		"10.0.0.0/8":     {"10.0.0.0", "255.0.0.0"},
+		"192.168.1.0/24": {"192.168.1.0", "255.255.255.0"},
+		"172.16.0.0/12":  {"172.16.0.0", "255.240.0.0"},
+		"2001:db8::/32":  {"2001:db8::", "32"},
+		"2001:db8::1":    {"2001:db8::1", ""},
+	}
+	for value, expected := range tests {
+		address, mask, err := ParseIPRange(value)
+		if err != nil {
+			t.Errorf("%s: %v", value, err)
+			continue
+		}
+		if address != expected[0] || mask != expected[1] {
+			t.Errorf("%s: expected %v, got [%s %s]", value, expected, address, mask)
+		}
+	}
+	for _, value := range []string{"", "10.0.0", "10.0.0.0/33", "example.com"} {
+		if _, _, err := ParseIPRange(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestFormatIPRange(t *testing.T) {
+	tests := map[[2]string]string{
+		{"10.0.0.1", ""}:                "10.0.0.1",
+		{"10.0.0.1", "255.255.255.255"}: "10.0.0.1",
+		{"10.0.0.0", "255.0.0.0"}:       "10.0.0.0/8",
+		{"172.16.0.0", "255.240.0.0"}:   "172.16.0.0/12",
+		{"2001:db8::", "32"}:            "2001:db8::/32",
+		{"10.0.0.0", "255.0.255.0"}:     "10.0.0.0/255.0.255.0",
+	}
+	for value, expected := range tests {
+		if formatted := FormatIPRange(value[0], value[1]); formatted != expected {
+			t.Errorf("%v: expected %s, got %s", value, expected, formatted)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const ipRestrictionsPath = "/api/webserver/ip-restrictions"
+
+type IPRestrictions struct {
+	Feature
+	AllowUnlisted            bool                     `json:"allow_unlisted"`
+	DenyAction               string                   `json:"deny_action"`
+	EnableReverseDNS         bool                     `json:"enable_reverse_dns"`
+	EnableProxyMode          bool                     `json:"enable_proxy_mode"`
+	LoggingOnlyMode          bool                     `json:"logging_only_mode"`
+	DenyByConcurrentRequests DenyByConcurrentRequests `json:"deny_by_concurrent_requests"`
+	DenyByRequestRate        DenyByRequestRate        `json:"deny_by_request_rate"`
+}
+
+// DenyByConcurrentRequests blocks clients exceeding a number of concurrent requests
+type DenyByConcurrentRequests struct {
+	Enabled               bool  `json:"enabled"`
+	MaxConcurrentRequests int64 `json:"max_concurrent_requests"`
+}
+
+// DenyByRequestRate blocks clients exceeding a number of requests within the time period
+type DenyByRequestRate struct {
+	Enabled     bool         `json:"enabled"`
+	MaxRequests int64        `json:"max_requests"`
+	TimePeriod  Milliseconds `json:"time_period"`
+}
+
+func (client Client) ReadIPRestrictions(ctx context.Context, scope Scope) (*IPRestrictions, error) {
+	var restrictions IPRestrictions
+	if err := getJson(ctx, client, featurePath(ipRestrictionsPath, scope), &restrictions); err != nil {
+		return nil, err
+	}
+	return &restrictions, nil
+}
+
+func (client Client) ReadIPRestrictionsByID(ctx context.Context, id string) (*IPRestrictions, error) {
+	url := fmt.Sprintf("%s/%s", ipRestrictionsPath, id)
+	var restrictions IPRestrictions
+	if err := getJson(ctx, client, url, &restrictions); err != nil {
+		return nil, err
+	}
+	return &restrictions, nil
+}
+
+// DeleteIPRestrictions removes the local ip restrictions of its scope, so the
+// restrictions are inherited again
+func (client Client) DeleteIPRestrictions(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, ipRestrictionsPath, id)
+}
@@ -0,0 +1,10 @@
+package iis
+
+import (
+	"context"
+)
+
+// UpdateIPRestrictions replaces the settings of the ip restrictions
+func (client Client) UpdateIPRestrictions(ctx context.Context, restrictions IPRestrictions) (*IPRestrictions, error) {
+	return updateFeature(ctx, client, ipRestrictionsPath, restrictions)
+}
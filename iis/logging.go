@@ -0,0 +1,66 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const loggingPath = "/api/webserver/logging"
+
+// Logging is the request log configuration of the server or a website
+type Logging struct {
+	Feature
+	Enabled bool `json:"enabled"`
+	// LogPerSite writes a log per website instead of one for the server, it
+	// can only be changed at server level
+	LogPerSite      *bool            `json:"log_per_site,omitempty"`
+	Directory       string           `json:"directory"`
+	LogFileEncoding string           `json:"log_file_encoding"`
+	LogFileFormat   string           `json:"log_file_format"`
+	LogTarget       LogTarget        `json:"log_target"`
+	LogFields       map[string]bool  `json:"log_fields"`
+	Rollover        LogRollover      `json:"rollover"`
+	CustomLogFields []CustomLogField `json:"custom_log_fields"`
+}
+
+type LogTarget struct {
+	File bool `json:"file"`
+	ETW  bool `json:"etw"`
+}
+
+// LogRollover decides when a new log file is started
+type LogRollover struct {
+	Period       string `json:"period"`
+	TruncateSize int64  `json:"truncate_size"`
+	UseLocalTime bool   `json:"use_local_time"`
+}
+
+// CustomLogField logs a request header, response header or server variable
+type CustomLogField struct {
+	FieldName  string `json:"field_name"`
+	SourceName string `json:"source_name"`
+	SourceType string `json:"source_type"`
+}
+
+func (client Client) ReadLogging(ctx context.Context, scope Scope) (*Logging, error) {
+	var logging Logging
+	if err := getJson(ctx, client, featurePath(loggingPath, scope), &logging); err != nil {
+		return nil, err
+	}
+	return &logging, nil
+}
+
+func (client Client) ReadLoggingByID(ctx context.Context, id string) (*Logging, error) {
+	url := fmt.Sprintf("%s/%s", loggingPath, id)
+	var logging Logging
+	if err := getJson(ctx, client, url, &logging); err != nil {
+		return nil, err
+	}
+	return &logging, nil
+}
+
+// DeleteLogging removes the local log configuration of a website, so the
+// server configuration is inherited again
+func (client Client) DeleteLogging(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, loggingPath, id)
+}
@@ -0,0 +1,10 @@
+package iis
+
+import (
+	"context"
+)
+
+// UpdateLogging replaces the log configuration, LogPerSite has to be nil below the server level
+func (client Client) UpdateLogging(ctx context.Context, logging Logging) (*Logging, error) {
+	return updateFeature(ctx, client, loggingPath, logging)
+}
@@ -0,0 +1,48 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const requestFilteringPath = "/api/webserver/http-request-filtering"
+
+type RequestFiltering struct {
+	Feature
+	AllowUnlistedFileExtensions bool                   `json:"allow_unlisted_file_extensions"`
+	AllowUnlistedVerbs          bool                   `json:"allow_unlisted_verbs"`
+	AllowHighBitCharacters      bool                   `json:"allow_high_bit_characters"`
+	AllowDoubleEscaping         bool                   `json:"allow_double_escaping"`
+	MaxContentLength            int64                  `json:"max_content_length"`
+	MaxUrlLength                int64                  `json:"max_url_length"`
+	MaxQueryStringLength        int64                  `json:"max_query_string_length"`
+	Verbs                       []RequestFilteringVerb `json:"verbs"`
+}
+
+type RequestFilteringVerb struct {
+	Name    string `json:"name"`
+	Allowed bool   `json:"allowed"`
+}
+
+func (client Client) ReadRequestFiltering(ctx context.Context, scope Scope) (*RequestFiltering, error) {
+	var filtering RequestFiltering
+	if err := getJson(ctx, client, featurePath(requestFilteringPath, scope), &filtering); err != nil {
+		return nil, err
+	}
+	return &filtering, nil
+}
+
+func (client Client) ReadRequestFilteringByID(ctx context.Context, id string) (*RequestFiltering, error) {
+	url := fmt.Sprintf("%s/%s", requestFilteringPath, id)
+	var filtering RequestFiltering
+	if err := getJson(ctx, client, url, &filtering); err != nil {
+		return nil, err
+	}
+	return &filtering, nil
+}
+
+// DeleteRequestFiltering removes the local request filtering configuration
+// of its scope, so the settings are inherited again
+func (client Client) DeleteRequestFiltering(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, requestFilteringPath, id)
+}
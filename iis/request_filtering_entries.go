@@ -0,0 +1,111 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	fileExtensionsPath         = requestFilteringPath + "/file-extensions"
+	hiddenSegmentsPath         = requestFilteringPath + "/hidden-segments"
+	queryStringsPath           = requestFilteringPath + "/query-strings"
+	requestFilteringRulesPath  = requestFilteringPath + "/rules"
+	requestFilteringQueryParam = "request_filtering.id"
+)
+
+type FileExtension struct {
+	Extension        string     `json:"extension"`
+	Allowed          bool       `json:"allowed"`
+	ID               string     `json:"id,omitempty"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+type HiddenSegment struct {
+	Segment          string     `json:"segment"`
+	ID               string     `json:"id,omitempty"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+type QueryString struct {
+	QueryString      string     `json:"query_string"`
+	Allow            bool       `json:"allow"`
+	ID               string     `json:"id,omitempty"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+// RequestFilteringRule denies requests containing one of the deny strings in
+// the scanned url, query string or headers
+type RequestFilteringRule struct {
+	Name             string     `json:"name"`
+	ScanUrl          bool       `json:"scan_url"`
+	ScanQueryString  bool       `json:"scan_query_string"`
+	Headers          []string   `json:"headers"`
+	AppliesTo        []string   `json:"applies_to"`
+	DenyStrings      []string   `json:"deny_strings"`
+	ID               string     `json:"id,omitempty"`
+	RequestFiltering *Reference `json:"request_filtering,omitempty"`
+}
+
+func (client Client) ListFileExtensions(ctx context.Context, requestFilteringID string) ([]FileExtension, error) {
+	return listFeatureEntries[FileExtension](ctx, client, fileExtensionsPath, "file_extensions", requestFilteringQueryParam, requestFilteringID)
+}
+
+func (client Client) CreateFileExtension(ctx context.Context, requestFilteringID string, extension FileExtension) (*FileExtension, error) {
+	extension.RequestFiltering = &Reference{ID: requestFilteringID}
+	return createFeatureEntry(ctx, client, fileExtensionsPath, extension)
+}
+
+func (client Client) UpdateFileExtension(ctx context.Context, id string, extension FileExtension) (*FileExtension, error) {
+	return updateFeatureEntry(ctx, client, fileExtensionsPath, id, extension)
+}
+
+func (client Client) DeleteFileExtension(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", fileExtensionsPath, id))
+}
+
+func (client Client) ListHiddenSegments(ctx context.Context, requestFilteringID string) ([]HiddenSegment, error) {
+	return listFeatureEntries[HiddenSegment](ctx, client, hiddenSegmentsPath, "hidden_segments", requestFilteringQueryParam, requestFilteringID)
+}
+
+func (client Client) CreateHiddenSegment(ctx context.Context, requestFilteringID string, segment HiddenSegment) (*HiddenSegment, error) {
+	segment.RequestFiltering = &Reference{ID: requestFilteringID}
+	return createFeatureEntry(ctx, client, hiddenSegmentsPath, segment)
+}
+
+func (client Client) DeleteHiddenSegment(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", hiddenSegmentsPath, id))
+}
+
+func (client Client) ListQueryStrings(ctx context.Context, requestFilteringID string) ([]QueryString, error) {
+	return listFeatureEntries[QueryString](ctx, client, queryStringsPath, "query_strings", requestFilteringQueryParam, requestFilteringID)
+}
+
+func (client Client) CreateQueryString(ctx context.Context, requestFilteringID string, queryString QueryString) (*QueryString, error) {
+	queryString.RequestFiltering = &Reference{ID: requestFilteringID}
+	return createFeatureEntry(ctx, client, queryStringsPath, queryString)
+}
+
+func (client Client) UpdateQueryString(ctx context.Context, id string, queryString QueryString) (*QueryString, error) {
+	return updateFeatureEntry(ctx, client, queryStringsPath, id, queryString)
+}
+
+func (client Client) DeleteQueryString(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", queryStringsPath, id))
+}
+
+func (client Client) ListRequestFilteringRules(ctx context.Context, requestFilteringID string) ([]RequestFilteringRule, error) {
+	return listFeatureEntries[RequestFilteringRule](ctx, client, requestFilteringRulesPath, "rules", requestFilteringQueryParam, requestFilteringID)
+}
+
+func (client Client) CreateRequestFilteringRule(ctx context.Context, requestFilteringID string, rule RequestFilteringRule) (*RequestFilteringRule, error) {
+	rule.RequestFiltering = &Reference{ID: requestFilteringID}
+	return createFeatureEntry(ctx, client, requestFilteringRulesPath, rule)
+}
+
+func (client Client) UpdateRequestFilteringRule(ctx context.Context, id string, rule RequestFilteringRule) (*RequestFilteringRule, error) {
+	return updateFeatureEntry(ctx, client, requestFilteringRulesPath, id, rule)
+}
+
+func (client Client) DeleteRequestFilteringRule(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", requestFilteringRulesPath, id))
+}
@@ -0,0 +1,10 @@
+package iis
+
+import (
+	"context"
+)
+
+// UpdateRequestFiltering replaces the settings and verbs of the request filtering
+func (client Client) UpdateRequestFiltering(ctx context.Context, filtering RequestFiltering) (*RequestFiltering, error) {
+	return updateFeature(ctx, client, requestFilteringPath, filtering)
+}
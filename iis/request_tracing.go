@@ -0,0 +1,97 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	requestTracingPath       = "/api/webserver/http-request-tracing"
+	requestTracingRulesPath  = requestTracingPath + "/rules"
+	requestTracingQueryParam = "request_tracing.id"
+)
+
+// RequestTracing is the failed request tracing (FREB) of a scope, which logs
+// a trace of the requests matching one of its rules
+type RequestTracing struct {
+	Feature
+	Enabled                 bool   `json:"enabled"`
+	Directory               string `json:"directory"`
+	MaximumNumberTraceFiles int64  `json:"maximum_number_trace_files"`
+}
+
+// RequestTracingRule traces requests to a path which end with one of the
+// status codes, take longer than the execution time or log an event of the severity
+type RequestTracingRule struct {
+	Path                    string                `json:"path"`
+	StatusCodes             []string              `json:"status_codes"`
+	MinRequestExecutionTime Milliseconds          `json:"min_request_execution_time"`
+	EventSeverity           string                `json:"event_severity"`
+	Traces                  []RequestTracingTrace `json:"traces"`
+	ID                      string                `json:"id,omitempty"`
+	RequestTracing          *Reference            `json:"request_tracing,omitempty"`
+}
+
+// RequestTracingTrace selects the events of a trace provider, e.g. WWW Server
+type RequestTracingTrace struct {
+	Provider     RequestTracingProvider `json:"provider"`
+	Verbosity    string                 `json:"verbosity"`
+	AllowedAreas map[string]bool        `json:"allowed_areas"`
+}
+
+type RequestTracingProvider struct {
+	Name string `json:"name"`
+}
+
+func (client Client) ReadRequestTracing(ctx context.Context, scope Scope) (*RequestTracing, error) {
+	var tracing RequestTracing
+	if err := getJson(ctx, client, featurePath(requestTracingPath, scope), &tracing); err != nil {
+		return nil, err
+	}
+	return &tracing, nil
+}
+
+func (client Client) ReadRequestTracingByID(ctx context.Context, id string) (*RequestTracing, error) {
+	url := fmt.Sprintf("%s/%s", requestTracingPath, id)
+	var tracing RequestTracing
+	if err := getJson(ctx, client, url, &tracing); err != nil {
+		return nil, err
+	}
+	return &tracing, nil
+}
+
+// UpdateRequestTracing replaces the settings of the request tracing
+func (client Client) UpdateRequestTracing(ctx context.Context, tracing RequestTracing) (*RequestTracing, error) {
+	return updateFeature(ctx, client, requestTracingPath, tracing)
+}
+
+// DeleteRequestTracing removes the local request tracing settings and rules
+// of its scope, so they are inherited again
+func (client Client) DeleteRequestTracing(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, requestTracingPath, id)
+}
+
+func (client Client) ListRequestTracingRules(ctx context.Context, requestTracingID string) ([]RequestTracingRule, error) {
+	return listFeatureEntries[RequestTracingRule](ctx, client, requestTracingRulesPath, "rules", requestTracingQueryParam, requestTracingID)
+}
+
+func (client Client) ReadRequestTracingRule(ctx context.Context, id string) (*RequestTracingRule, error) {
+	var rule RequestTracingRule
+	if err := getJson(ctx, client, fmt.Sprintf("%s/%s", requestTracingRulesPath, id), &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (client Client) CreateRequestTracingRule(ctx context.Context, requestTracingID string, rule RequestTracingRule) (*RequestTracingRule, error) {
+	rule.RequestTracing = &Reference{ID: requestTracingID}
+	return createFeatureEntry(ctx, client, requestTracingRulesPath, rule)
+}
+
+func (client Client) UpdateRequestTracingRule(ctx context.Context, id string, rule RequestTracingRule) (*RequestTracingRule, error) {
+	return updateFeatureEntry(ctx, client, requestTracingRulesPath, id, rule)
+}
+
+func (client Client) DeleteRequestTracingRule(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", requestTracingRulesPath, id))
+}
@@ -0,0 +1,62 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const responseCompressionPath = "/api/webserver/http-response-compression"
+
+// ResponseCompression holds whether static and dynamic responses of a scope
+// are compressed. The remaining settings can only be changed at server level.
+type ResponseCompression struct {
+	Feature
+	DoStaticCompression  bool   `json:"do_static_compression"`
+	DoDynamicCompression bool   `json:"do_dynamic_compression"`
+	Directory            string `json:"directory"`
+	// The field name is misspelled by the IIS Administration API
+	DoDiskSpaceLimiting   bool                  `json:"do_disk_space_limitting"`
+	MaxDiskSpaceUsage     int64                 `json:"max_disk_space_usage"`
+	MinFileSizeToCompress int64                 `json:"min_file_size_to_compress"`
+	Schemes               []CompressionScheme   `json:"schemes"`
+	DynamicTypes          []CompressionMimeType `json:"dynamic_types"`
+	StaticTypes           []CompressionMimeType `json:"static_types"`
+}
+
+// CompressionScheme is a compression algorithm, e.g. gzip, and its compression levels
+type CompressionScheme struct {
+	Name                    string `json:"name"`
+	Dll                     string `json:"dll"`
+	DynamicCompressionLevel int64  `json:"dynamic_compression_level"`
+	StaticCompressionLevel  int64  `json:"static_compression_level"`
+}
+
+// CompressionMimeType enables or disables compression of responses with a
+// mime type, wildcards like text/* are allowed
+type CompressionMimeType struct {
+	MimeType string `json:"mime_type"`
+	Enabled  bool   `json:"enabled"`
+}
+
+func (client Client) ReadResponseCompression(ctx context.Context, scope Scope) (*ResponseCompression, error) {
+	var compression ResponseCompression
+	if err := getJson(ctx, client, featurePath(responseCompressionPath, scope), &compression); err != nil {
+		return nil, err
+	}
+	return &compression, nil
+}
+
+func (client Client) ReadResponseCompressionByID(ctx context.Context, id string) (*ResponseCompression, error) {
+	url := fmt.Sprintf("%s/%s", responseCompressionPath, id)
+	var compression ResponseCompression
+	if err := getJson(ctx, client, url, &compression); err != nil {
+		return nil, err
+	}
+	return &compression, nil
+}
+
+// DeleteResponseCompression removes the local compression settings of its
+// scope, so the settings are inherited again
+func (client Client) DeleteResponseCompression(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, responseCompressionPath, id)
+}
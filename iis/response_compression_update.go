@@ -0,0 +1,34 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (client Client) UpdateResponseCompression(ctx context.Context, id string, request UpdateResponseCompressionRequest) (*ResponseCompression, error) {
+	url := fmt.Sprintf("%s/%s", responseCompressionPath, id)
+	res, err := httpPatch(ctx, client, url, request)
+	if err != nil {
+		return nil, err
+	}
+	var compression ResponseCompression
+	if err := json.Unmarshal(res, &compression); err != nil {
+		return nil, err
+	}
+	return &compression, nil
+}
+
+// UpdateResponseCompressionRequest changes the set fields, the server level
+// settings are locked below the server and must be left unset there
+type UpdateResponseCompressionRequest struct {
+	DoStaticCompression   *bool                 `json:"do_static_compression,omitempty"`
+	DoDynamicCompression  *bool                 `json:"do_dynamic_compression,omitempty"`
+	Directory             *string               `json:"directory,omitempty"`
+	DoDiskSpaceLimiting   *bool                 `json:"do_disk_space_limitting,omitempty"`
+	MaxDiskSpaceUsage     *int64                `json:"max_disk_space_usage,omitempty"`
+	MinFileSizeToCompress *int64                `json:"min_file_size_to_compress,omitempty"`
+	Schemes               []CompressionScheme   `json:"schemes,omitempty"`
+	DynamicTypes          []CompressionMimeType `json:"dynamic_types,omitempty"`
+	StaticTypes           []CompressionMimeType `json:"static_types,omitempty"`
+}
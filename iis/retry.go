@@ -0,0 +1,143 @@
+package iis
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryRule describes which failures are retried for a single HTTP method
+type RetryRule struct {
+	// StatusCodes are the response status codes that trigger a retry
+	StatusCodes []int
+	// NetworkErrors enables retries when the request fails before a response is received
+	NetworkErrors bool
+}
+
+// RetryPolicy controls how failed requests against the IIS Administration API are retried
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+	// BaseBackoff is the delay before the first retry, doubled on every further attempt
+	BaseBackoff time.Duration
+	// MaxBackoff caps the exponential backoff. A Retry-After header may exceed it.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (0-1) of the backoff that is randomized
+	Jitter float64
+	// Methods overrides the retry rule per HTTP method. Methods without an
+	// entry use DefaultRetryRule.
+	Methods map[string]RetryRule
+}
+
+// idempotentRetryRule retries authentication hiccups during NTLM negotiation,
+// rate limiting, server errors and network errors
+var idempotentRetryRule = RetryRule{
+	StatusCodes:   []int{401, 429, 500, 502, 503, 504},
+	NetworkErrors: true,
+}
+
+// nonIdempotentRetryRule only retries responses which guarantee the request
+// was not processed, as a replayed POST can create duplicate resources
+var nonIdempotentRetryRule = RetryRule{
+	StatusCodes: []int{401, 429},
+}
+
+// DefaultRetryRule applies to methods without an explicit rule
+var DefaultRetryRule = nonIdempotentRetryRule
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		BaseBackoff: 1 * time.Second,
+		MaxBackoff:  16 * time.Second,
+		Jitter:      0.2,
+		Methods: map[string]RetryRule{
+			http.MethodGet:    idempotentRetryRule,
+			http.MethodHead:   idempotentRetryRule,
+			http.MethodPut:    idempotentRetryRule,
+			http.MethodPatch:  idempotentRetryRule,
+			http.MethodDelete: idempotentRetryRule,
+			http.MethodPost:   nonIdempotentRetryRule,
+		},
+	}
+}
+
+func (client Client) retryPolicy() RetryPolicy {
+	if client.RetryPolicy == nil {
+		return DefaultRetryPolicy()
+	}
+	return *client.RetryPolicy
+}
+
+func (policy RetryPolicy) rule(method string) RetryRule {
+	if rule, ok := policy.Methods[strings.ToUpper(method)]; ok {
+		return rule
+	}
+	return DefaultRetryRule
+}
+
+func (policy RetryPolicy) canRetry(attempt int) bool {
+	return attempt < policy.MaxAttempts
+}
+
+func (policy RetryPolicy) retryNetworkError(method string) bool {
+	return policy.rule(method).NetworkErrors
+}
+
+func (policy RetryPolicy) retryStatusCode(method string, statusCode int) bool {
+	for _, code := range policy.rule(method).StatusCodes {
+		if code == statusCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff calculates the delay after the given (1-based) attempt. A delay
+// requested by the server through Retry-After takes precedence if it is longer.
+func (policy RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	delay := policy.BaseBackoff
+	for i := 1; i < attempt && delay < policy.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if policy.MaxBackoff > 0 && delay > policy.MaxBackoff {
+		delay = policy.MaxBackoff
+	}
+	if policy.Jitter > 0 {
+		delay -= time.Duration(rand.Float64() * policy.Jitter * float64(delay))
+	}
+	if retryAfter > delay {
+		return retryAfter
+	}
+	return delay
+}
+
+// parseRetryAfter reads the Retry-After header, which is either a number of seconds or an HTTP date
+func parseRetryAfter(response *http.Response) time.Duration {
+	value := strings.TrimSpace(response.Header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
+
+// sleepContext waits for the given duration or until the context is cancelled
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
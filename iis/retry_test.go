@@ -0,0 +1,107 @@
+package iis
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	policy.MaxBackoff = 4 * time.Millisecond
+	return &policy
+}
+
+func countingServer(t *testing.T, handler http.HandlerFunc) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls
+}
+
+func TestRequest_retriesIdempotentServerErrors(t *testing.T) {
+	server, calls := countingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client := Client{Host: server.URL, RetryPolicy: testRetryPolicy()}
+
+	_, err := httpGet(context.Background(), client, "/api/webserver/websites")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if *calls != 5 {
+		t.Errorf("expected 5 attempts, got %d", *calls)
+	}
+}
+
+func TestRequest_doesNotRetryPostOnServerError(t *testing.T) {
+	server, calls := countingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	client := Client{Host: server.URL, RetryPolicy: testRetryPolicy()}
+
+	_, err := httpPost(context.Background(), client, "/api/webserver/webapps", struct{}{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if *calls != 1 {
+		t.Errorf("expected a single attempt, got %d", *calls)
+	}
+}
+
+func TestRequest_doesNotRetryForbidden(t *testing.T) {
+	server, calls := countingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	client := Client{Host: server.URL, RetryPolicy: testRetryPolicy()}
+
+	if _, err := httpGet(context.Background(), client, "/api/webserver/websites"); err == nil {
+		t.Fatal("expected error")
+	}
+	if *calls != 1 {
+		t.Errorf("expected a single attempt, got %d", *calls)
+	}
+}
+
+func TestRequest_abortsBackoffOnContextCancellation(t *testing.T) {
+	server, calls := countingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client := Client{Host: server.URL, RetryPolicy: testRetryPolicy()}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := httpGet(ctx, client, "/api/webserver/websites")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("backoff was not aborted, took %s", elapsed)
+	}
+	if *calls != 1 {
+		t.Errorf("expected a single attempt, got %d", *calls)
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	policy := RetryPolicy{BaseBackoff: time.Second, MaxBackoff: 16 * time.Second}
+	expected := []time.Duration{1, 2, 4, 8, 16, 16}
+	for i, want := range expected {
+		if got := policy.backoff(i+1, 0); got != want*time.Second {
+			t.Errorf("attempt %d: expected %s, got %s", i+1, want*time.Second, got)
+		}
+	}
+	if got := policy.backoff(1, 30*time.Second); got != 30*time.Second {
+		t.Errorf("expected Retry-After to take precedence, got %s", got)
+	}
+}
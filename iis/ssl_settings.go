@@ -0,0 +1,39 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const sslSettingsPath = "/api/webserver/ssl-settings"
+
+// SSLSettings decide whether a website or application requires https and client certificates
+type SSLSettings struct {
+	Feature
+	RequireSSL bool `json:"require_ssl"`
+	// ClientCertificates is ignore, accept or require
+	ClientCertificates string `json:"client_certificates"`
+}
+
+func (client Client) ReadSSLSettings(ctx context.Context, scope Scope) (*SSLSettings, error) {
+	var settings SSLSettings
+	if err := getJson(ctx, client, featurePath(sslSettingsPath, scope), &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (client Client) ReadSSLSettingsByID(ctx context.Context, id string) (*SSLSettings, error) {
+	url := fmt.Sprintf("%s/%s", sslSettingsPath, id)
+	var settings SSLSettings
+	if err := getJson(ctx, client, url, &settings); err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+// DeleteSSLSettings removes the local ssl settings of its scope, so the
+// settings are inherited again
+func (client Client) DeleteSSLSettings(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, sslSettingsPath, id)
+}
@@ -0,0 +1,10 @@
+package iis
+
+import (
+	"context"
+)
+
+// UpdateSSLSettings replaces the ssl settings
+func (client Client) UpdateSSLSettings(ctx context.Context, settings SSLSettings) (*SSLSettings, error) {
+	return updateFeature(ctx, client, sslSettingsPath, settings)
+}
@@ -0,0 +1,77 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	staticContentPath       = "/api/webserver/static-content"
+	mimeMapsPath            = staticContentPath + "/mime-maps"
+	staticContentQueryParam = "static_content.id"
+)
+
+// StaticContent are the settings of the static file handler of the server, a website or an application
+type StaticContent struct {
+	Feature
+	ClientCache ClientCache `json:"client_cache"`
+}
+
+// ClientCache decides which caching headers are sent with static files
+type ClientCache struct {
+	MaxAge Minutes `json:"max_age"`
+	// ControlMode is no_control, disable_cache, use_max_age or use_expires
+	ControlMode   string `json:"control_mode"`
+	ControlCustom string `json:"control_custom"`
+	SetETag       bool   `json:"set_e_tag"`
+	// HttpExpires is the RFC 1123 date sent in use_expires mode
+	HttpExpires string `json:"http_expires"`
+}
+
+// MimeMap maps a file extension to the content type static files are served with
+type MimeMap struct {
+	FileExtension string     `json:"file_extension"`
+	MimeType      string     `json:"mime_type"`
+	ID            string     `json:"id,omitempty"`
+	StaticContent *Reference `json:"static_content,omitempty"`
+}
+
+func (client Client) ReadStaticContent(ctx context.Context, scope Scope) (*StaticContent, error) {
+	var content StaticContent
+	if err := getJson(ctx, client, featurePath(staticContentPath, scope), &content); err != nil {
+		return nil, err
+	}
+	return &content, nil
+}
+
+func (client Client) ReadStaticContentByID(ctx context.Context, id string) (*StaticContent, error) {
+	url := fmt.Sprintf("%s/%s", staticContentPath, id)
+	var content StaticContent
+	if err := getJson(ctx, client, url, &content); err != nil {
+		return nil, err
+	}
+	return &content, nil
+}
+
+// DeleteStaticContent removes the local static content settings and mime maps
+// of its scope, so they are inherited again
+func (client Client) DeleteStaticContent(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, staticContentPath, id)
+}
+
+func (client Client) ListMimeMaps(ctx context.Context, staticContentID string) ([]MimeMap, error) {
+	return listFeatureEntries[MimeMap](ctx, client, mimeMapsPath, "mime_maps", staticContentQueryParam, staticContentID)
+}
+
+func (client Client) CreateMimeMap(ctx context.Context, staticContentID string, mimeMap MimeMap) (*MimeMap, error) {
+	mimeMap.StaticContent = &Reference{ID: staticContentID}
+	return createFeatureEntry(ctx, client, mimeMapsPath, mimeMap)
+}
+
+func (client Client) UpdateMimeMap(ctx context.Context, id string, mimeMap MimeMap) (*MimeMap, error) {
+	return updateFeatureEntry(ctx, client, mimeMapsPath, id, mimeMap)
+}
+
+func (client Client) DeleteMimeMap(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", mimeMapsPath, id))
+}
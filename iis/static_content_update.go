@@ -0,0 +1,10 @@
+package iis
+
+import (
+	"context"
+)
+
+// UpdateStaticContent replaces the static content settings, the mime maps are managed separately
+func (client Client) UpdateStaticContent(ctx context.Context, content StaticContent) (*StaticContent, error) {
+	return updateFeature(ctx, client, staticContentPath, content)
+}
@@ -0,0 +1,195 @@
+package iis
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Minutes is a TimeSpan setting which the API transfers as a number of minutes
+// (e.g. idle_timeout or periodic_restart.time_interval)
+type Minutes time.Duration
+
+// Seconds is a TimeSpan setting which the API transfers as a number of seconds
+// (e.g. ping_interval or startup_time_limit)
+type Seconds time.Duration
+
+// Milliseconds is a TimeSpan setting which the API transfers as a number of milliseconds
+// (e.g. deny_by_request_rate.time_period)
+type Milliseconds time.Duration
+
+func (m Minutes) Duration() time.Duration {
+	return time.Duration(m)
+}
+
+func (m Minutes) MarshalJSON() ([]byte, error) {
+	return marshalTimeSpan(time.Duration(m), time.Minute)
+}
+
+func (m *Minutes) UnmarshalJSON(data []byte) error {
+	duration, err := unmarshalTimeSpan(data, time.Minute)
+	*m = Minutes(duration)
+	return err
+}
+
+func (s Seconds) Duration() time.Duration {
+	return time.Duration(s)
+}
+
+func (s Seconds) MarshalJSON() ([]byte, error) {
+	return marshalTimeSpan(time.Duration(s), time.Second)
+}
+
+func (s *Seconds) UnmarshalJSON(data []byte) error {
+	duration, err := unmarshalTimeSpan(data, time.Second)
+	*s = Seconds(duration)
+	return err
+}
+
+func (m Milliseconds) Duration() time.Duration {
+	return time.Duration(m)
+}
+
+func (m Milliseconds) MarshalJSON() ([]byte, error) {
+	return marshalTimeSpan(time.Duration(m), time.Millisecond)
+}
+
+func (m *Milliseconds) UnmarshalJSON(data []byte) error {
+	duration, err := unmarshalTimeSpan(data, time.Millisecond)
+	*m = Milliseconds(duration)
+	return err
+}
+
+func marshalTimeSpan(duration time.Duration, unit time.Duration) ([]byte, error) {
+	if duration%unit == 0 {
+		return json.Marshal(int64(duration / unit))
+	}
+	return json.Marshal(float64(duration) / float64(unit))
+}
+
+// unmarshalTimeSpan accepts a number of the given unit or a TimeSpan string
+func unmarshalTimeSpan(data []byte, unit time.Duration) (time.Duration, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return 0, err
+	}
+	switch value := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return time.Duration(math.Round(value * float64(unit))), nil
+	case string:
+		return ParseTimeSpan(value)
+	default:
+		return 0, fmt.Errorf("invalid time span: %s", data)
+	}
+}
+
+var (
+	isoDurationPattern    = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+	dotnetTimeSpanPattern = regexp.MustCompile(`^(?:(\d+)\.)?(\d{1,2}):(\d{2})(?::(\d{2}(?:\.\d+)?))?$`)
+)
+
+// ParseTimeSpan parses a Go ("20m", "1h30m"), ISO 8601 ("PT1H29M") or
+// .NET ("1.02:30:00") duration
+func ParseTimeSpan(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	if match := isoDurationPattern.FindStringSubmatch(strings.ToUpper(value)); match != nil && strings.Join(match[1:], "") != "" {
+		return timeSpanFromParts(match[1], match[2], match[3], match[4])
+	}
+	if match := dotnetTimeSpanPattern.FindStringSubmatch(value); match != nil && validClock(match[2], match[3], match[4]) {
+		return timeSpanFromParts(match[1], match[2], match[3], match[4])
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time span %q, expected a duration like 20m, 1h30m or PT1H29M", value)
+	}
+	return duration, nil
+}
+
+func timeSpanFromParts(days, hours, minutes, seconds string) (time.Duration, error) {
+	var duration time.Duration
+	for _, part := range []struct {
+		value string
+		unit  time.Duration
+	}{{days, 24 * time.Hour}, {hours, time.Hour}, {minutes, time.Minute}} {
+		if part.value == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(part.value, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		duration += time.Duration(n) * part.unit
+	}
+	if seconds != "" {
+		n, err := strconv.ParseFloat(seconds, 64)
+		if err != nil {
+			return 0, err
+		}
+		duration += time.Duration(math.Round(n * float64(time.Second)))
+	}
+	return duration, nil
+}
+
+// validClock checks the hours, minutes and seconds of a .NET TimeSpan are in range
+func validClock(hours, minutes, seconds string) bool {
+	h, _ := strconv.Atoi(hours)
+	m, _ := strconv.Atoi(minutes)
+	sec, _ := strconv.ParseFloat(seconds, 64)
+	return h < 24 && m < 60 && sec < 60
+}
+
+// FormatTimeSpan formats a duration in its shortest Go notation, e.g. "1h29m" instead of "1h29m0s"
+func FormatTimeSpan(duration time.Duration) string {
+	formatted := duration.String()
+	if strings.HasSuffix(formatted, "m0s") {
+		formatted = strings.TrimSuffix(formatted, "0s")
+	}
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+	return formatted
+}
+
+// TimeOfDay is a time of day, e.g. of a scheduled recycle, which the API transfers as "hh:mm"
+type TimeOfDay time.Duration
+
+var timeOfDayPattern = regexp.MustCompile(`^([01]?\d|2[0-3]):([0-5]\d)(?::([0-5]\d))?$`)
+
+// ParseTimeOfDay parses a time of day in 24-hour "hh:mm" or "hh:mm:ss" notation
+func ParseTimeOfDay(value string) (TimeOfDay, error) {
+	match := timeOfDayPattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected hh:mm between 00:00 and 23:59", value)
+	}
+	duration, err := timeSpanFromParts("", match[1], match[2], match[3])
+	return TimeOfDay(duration), err
+}
+
+func (t TimeOfDay) String() string {
+	duration := time.Duration(t)
+	formatted := fmt.Sprintf("%02d:%02d", int(duration.Hours()), int(duration.Minutes())%60)
+	if seconds := int(duration.Seconds()) % 60; seconds != 0 {
+		formatted += fmt.Sprintf(":%02d", seconds)
+	}
+	return formatted
+}
+
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseTimeOfDay(value)
+	*t = parsed
+	return err
+}
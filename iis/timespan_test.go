@@ -0,0 +1,97 @@
+package iis
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTimeSpan(t *testing.T) {
+	tests := map[string]time.Duration{
+		"20m":        20 * time.Minute,
+		"1h30m":      90 * time.Minute,
+		"PT1H29M":    89 * time.Minute,
+		"pt90s":      90 * time.Second,
+		"P1DT5H":     29 * time.Hour,
+		"PT1.5S":     1500 * time.Millisecond,
+		"00:20:00":   20 * time.Minute,
+		"1.05:00:00": 29 * time.Hour,
+		"0":          0,
+	}
+	for value, expected := range tests {
+		duration, err := ParseTimeSpan(value)
+		if err != nil {
+			t.Errorf("%s: %v", value, err)
+			continue
+		}
+		if duration != expected {
+			t.Errorf("%s: expected %s, got %s", value, expected, duration)
+		}
+	}
+	for _, value := range []string{"", "P", "PT", "20", "1h-", "25:00"} {
+		if _, err := ParseTimeSpan(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+func TestFormatTimeSpan(t *testing.T) {
+	tests := map[time.Duration]string{
+		0:                       "0s",
+		90 * time.Second:        "1m30s",
+		20 * time.Minute:        "20m",
+		29 * time.Hour:          "29h",
+		89 * time.Minute:        "1h29m",
+		1500 * time.Millisecond: "1.5s",
+	}
+	for duration, expected := range tests {
+		if formatted := FormatTimeSpan(duration); formatted != expected {
+			t.Errorf("%s: expected %s, got %s", duration, expected, formatted)
+		}
+	}
+}
+
+func TestTimeSpan_json(t *testing.T) {
+	var model struct {
+		IdleTimeout  Minutes `json:"idle_timeout"`
+		PingInterval Seconds `json:"ping_interval"`
+	}
+	if err := json.Unmarshal([]byte(`{"idle_timeout":20.5,"ping_interval":"00:00:30"}`), &model); err != nil {
+		t.Fatal(err)
+	}
+	if model.IdleTimeout.Duration() != 20*time.Minute+30*time.Second || model.PingInterval.Duration() != 30*time.Second {
+		t.Errorf("unexpected durations: %s, %s", model.IdleTimeout.Duration(), model.PingInterval.Duration())
+	}
+	data, err := json.Marshal(model)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"idle_timeout":20.5,"ping_interval":30}` {
+		t.Errorf("unexpected json: %s", data)
+	}
+}
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := map[string]string{
+		"02:00":    "02:00",
+		"2:00":     "02:00",
+		"14:30":    "14:30",
+		"23:59:30": "23:59:30",
+		"00:00:00": "00:00",
+	}
+	for value, expected := range tests {
+		timeOfDay, err := ParseTimeOfDay(value)
+		if err != nil {
+			t.Errorf("%s: %v", value, err)
+			continue
+		}
+		if timeOfDay.String() != expected {
+			t.Errorf("%s: expected %s, got %s", value, expected, timeOfDay)
+		}
+	}
+	for _, value := range []string{"", "24:00", "12:60", "1230", "2pm"} {
+		if _, err := ParseTimeOfDay(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
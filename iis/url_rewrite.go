@@ -0,0 +1,49 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+const urlRewritePath = "/api/webserver/url-rewrite"
+
+// UrlRewriteSection is a configuration section of the URL Rewrite module,
+// which holds the rules or maps of a scope
+type UrlRewriteSection string
+
+const (
+	InboundRulesSection  UrlRewriteSection = "inbound"
+	OutboundRulesSection UrlRewriteSection = "outbound"
+	RewriteMapsSection   UrlRewriteSection = "rewrite-maps"
+)
+
+func (section UrlRewriteSection) path() string {
+	return urlRewritePath + "/" + string(section)
+}
+
+// ReadUrlRewriteSection returns the section of a scope, its id is required to add rules or maps
+func (client Client) ReadUrlRewriteSection(ctx context.Context, section UrlRewriteSection, scope Scope) (*Feature, error) {
+	var feature Feature
+	if err := getJson(ctx, client, featurePath(section.path(), scope), &feature); err != nil {
+		return nil, err
+	}
+	return &feature, nil
+}
+
+func (client Client) ReadUrlRewriteSectionByID(ctx context.Context, section UrlRewriteSection, id string) (*Feature, error) {
+	url := fmt.Sprintf("%s/%s", section.path(), id)
+	var feature Feature
+	if err := getJson(ctx, client, url, &feature); err != nil {
+		return nil, err
+	}
+	return &feature, nil
+}
+
+// RewriteCondition is a condition of an inbound or outbound rule
+type RewriteCondition struct {
+	Input      string `json:"input"`
+	Pattern    string `json:"pattern"`
+	Negate     bool   `json:"negate"`
+	IgnoreCase bool   `json:"ignore_case"`
+	MatchType  string `json:"match_type"`
+}
@@ -0,0 +1,73 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+var inboundRulesPath = InboundRulesSection.path() + "/rules"
+
+// InboundRule rewrites or redirects requests. Rules are evaluated by their
+// priority, the zero-based position in the section.
+type InboundRule struct {
+	Name                      string                  `json:"name"`
+	ID                        string                  `json:"id,omitempty"`
+	Priority                  *int                    `json:"priority,omitempty"`
+	Pattern                   string                  `json:"pattern"`
+	PatternSyntax             string                  `json:"pattern_syntax"`
+	IgnoreCase                bool                    `json:"ignore_case"`
+	Negate                    bool                    `json:"negate"`
+	StopProcessing            bool                    `json:"stop_processing"`
+	ConditionMatchConstraints string                  `json:"condition_match_constraints"`
+	TrackAllCaptures          bool                    `json:"track_all_captures"`
+	Conditions                []RewriteCondition      `json:"conditions"`
+	ServerVariables           []RewriteServerVariable `json:"server_variables"`
+	Action                    InboundRuleAction       `json:"action"`
+	InboundRules              *Reference              `json:"inbound_rules,omitempty"`
+}
+
+// RewriteServerVariable is set by an inbound rule before the request is processed
+type RewriteServerVariable struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Replace bool   `json:"replace"`
+}
+
+type InboundRuleAction struct {
+	Type              string `json:"type"`
+	Url               string `json:"url"`
+	AppendQueryString bool   `json:"append_query_string"`
+	LogRewrittenUrl   bool   `json:"log_rewritten_url"`
+	RedirectType      string `json:"redirect_type,omitempty"`
+	StatusCode        int64  `json:"status_code,omitempty"`
+	SubStatusCode     int64  `json:"sub_status_code,omitempty"`
+	StatusReason      string `json:"status_reason,omitempty"`
+	Description       string `json:"description,omitempty"`
+}
+
+func (client Client) ListInboundRules(ctx context.Context, sectionID string) ([]InboundRule, error) {
+	return listFeatureEntries[InboundRule](ctx, client, inboundRulesPath, "rules", "inbound_rules.id", sectionID)
+}
+
+func (client Client) ReadInboundRule(ctx context.Context, id string) (*InboundRule, error) {
+	var rule InboundRule
+	if err := getJson(ctx, client, fmt.Sprintf("%s/%s", inboundRulesPath, id), &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// CreateInboundRule adds a rule to the section, at the end if no priority is set
+func (client Client) CreateInboundRule(ctx context.Context, sectionID string, rule InboundRule) (*InboundRule, error) {
+	rule.InboundRules = &Reference{ID: sectionID}
+	return createFeatureEntry(ctx, client, inboundRulesPath, rule)
+}
+
+// UpdateInboundRule changes a rule, setting the priority moves it within the section
+func (client Client) UpdateInboundRule(ctx context.Context, id string, rule InboundRule) (*InboundRule, error) {
+	return updateFeatureEntry(ctx, client, inboundRulesPath, id, rule)
+}
+
+func (client Client) DeleteInboundRule(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", inboundRulesPath, id))
+}
@@ -0,0 +1,48 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+var rewriteMapsPath = RewriteMapsSection.path() + "/entries"
+
+// RewriteMap maps keys to values, e.g. old to new urls, for use in rules as {MapName:{REQUEST_URI}}
+type RewriteMap struct {
+	Name         string           `json:"name"`
+	ID           string           `json:"id,omitempty"`
+	DefaultValue string           `json:"default_value"`
+	IgnoreCase   bool             `json:"ignore_case"`
+	Mappings     []RewriteMapping `json:"mappings"`
+	RewriteMaps  *Reference       `json:"rewrite_maps,omitempty"`
+}
+
+type RewriteMapping struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+func (client Client) ListRewriteMaps(ctx context.Context, sectionID string) ([]RewriteMap, error) {
+	return listFeatureEntries[RewriteMap](ctx, client, rewriteMapsPath, "entries", "rewrite_maps.id", sectionID)
+}
+
+func (client Client) ReadRewriteMap(ctx context.Context, id string) (*RewriteMap, error) {
+	var rewriteMap RewriteMap
+	if err := getJson(ctx, client, fmt.Sprintf("%s/%s", rewriteMapsPath, id), &rewriteMap); err != nil {
+		return nil, err
+	}
+	return &rewriteMap, nil
+}
+
+func (client Client) CreateRewriteMap(ctx context.Context, sectionID string, rewriteMap RewriteMap) (*RewriteMap, error) {
+	rewriteMap.RewriteMaps = &Reference{ID: sectionID}
+	return createFeatureEntry(ctx, client, rewriteMapsPath, rewriteMap)
+}
+
+func (client Client) UpdateRewriteMap(ctx context.Context, id string, rewriteMap RewriteMap) (*RewriteMap, error) {
+	return updateFeatureEntry(ctx, client, rewriteMapsPath, id, rewriteMap)
+}
+
+func (client Client) DeleteRewriteMap(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", rewriteMapsPath, id))
+}
@@ -0,0 +1,63 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+)
+
+var outboundRulesPath = OutboundRulesSection.path() + "/rules"
+
+// OutboundRule rewrites a server variable or the tags of a response. Rules
+// are evaluated by their priority, the zero-based position in the section.
+type OutboundRule struct {
+	Name                      string             `json:"name"`
+	ID                        string             `json:"id,omitempty"`
+	Priority                  *int               `json:"priority,omitempty"`
+	Precondition              string             `json:"precondition"`
+	MatchType                 string             `json:"match_type"`
+	ServerVariable            string             `json:"server_variable"`
+	TagFilters                map[string]bool    `json:"tag_filters"`
+	Pattern                   string             `json:"pattern"`
+	PatternSyntax             string             `json:"pattern_syntax"`
+	IgnoreCase                bool               `json:"ignore_case"`
+	Negate                    bool               `json:"negate"`
+	StopProcessing            bool               `json:"stop_processing"`
+	ConditionMatchConstraints string             `json:"condition_match_constraints"`
+	TrackAllCaptures          bool               `json:"track_all_captures"`
+	Conditions                []RewriteCondition `json:"conditions"`
+	Action                    OutboundRuleAction `json:"action"`
+	OutboundRules             *Reference         `json:"outbound_rules,omitempty"`
+}
+
+type OutboundRuleAction struct {
+	Type                  string `json:"type"`
+	Value                 string `json:"value"`
+	ReplaceServerVariable bool   `json:"replace_server_variable"`
+}
+
+func (client Client) ListOutboundRules(ctx context.Context, sectionID string) ([]OutboundRule, error) {
+	return listFeatureEntries[OutboundRule](ctx, client, outboundRulesPath, "rules", "outbound_rules.id", sectionID)
+}
+
+func (client Client) ReadOutboundRule(ctx context.Context, id string) (*OutboundRule, error) {
+	var rule OutboundRule
+	if err := getJson(ctx, client, fmt.Sprintf("%s/%s", outboundRulesPath, id), &rule); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// CreateOutboundRule adds a rule to the section, at the end if no priority is set
+func (client Client) CreateOutboundRule(ctx context.Context, sectionID string, rule OutboundRule) (*OutboundRule, error) {
+	rule.OutboundRules = &Reference{ID: sectionID}
+	return createFeatureEntry(ctx, client, outboundRulesPath, rule)
+}
+
+// UpdateOutboundRule changes a rule, setting the priority moves it within the section
+func (client Client) UpdateOutboundRule(ctx context.Context, id string, rule OutboundRule) (*OutboundRule, error) {
+	return updateFeatureEntry(ctx, client, outboundRulesPath, id, rule)
+}
+
+func (client Client) DeleteOutboundRule(ctx context.Context, id string) error {
+	return httpDelete(ctx, client, fmt.Sprintf("%s/%s", outboundRulesPath, id))
+}
@@ -0,0 +1,55 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+const allowedServerVariablesPath = urlRewritePath + "/allowed-server-variables"
+
+// AllowedServerVariables are the server variables inbound rules may set
+type AllowedServerVariables struct {
+	Feature
+	Entries []string `json:"entries"`
+}
+
+func (client Client) ReadAllowedServerVariables(ctx context.Context, scope Scope) (*AllowedServerVariables, error) {
+	var variables AllowedServerVariables
+	if err := getJson(ctx, client, featurePath(allowedServerVariablesPath, scope), &variables); err != nil {
+		return nil, err
+	}
+	return &variables, nil
+}
+
+func (client Client) ReadAllowedServerVariablesByID(ctx context.Context, id string) (*AllowedServerVariables, error) {
+	url := fmt.Sprintf("%s/%s", allowedServerVariablesPath, id)
+	var variables AllowedServerVariables
+	if err := getJson(ctx, client, url, &variables); err != nil {
+		return nil, err
+	}
+	return &variables, nil
+}
+
+func (client Client) UpdateAllowedServerVariables(ctx context.Context, id string, request UpdateAllowedServerVariablesRequest) (*AllowedServerVariables, error) {
+	url := fmt.Sprintf("%s/%s", allowedServerVariablesPath, id)
+	res, err := httpPatch(ctx, client, url, request)
+	if err != nil {
+		return nil, err
+	}
+	var variables AllowedServerVariables
+	if err := json.Unmarshal(res, &variables); err != nil {
+		return nil, err
+	}
+	return &variables, nil
+}
+
+type UpdateAllowedServerVariablesRequest struct {
+	Entries []string `json:"entries"`
+}
+
+// DeleteAllowedServerVariables removes the local allowed server variables of
+// its scope, so the variables are inherited again
+func (client Client) DeleteAllowedServerVariables(ctx context.Context, id string) error {
+	return deleteFeature(ctx, client, allowedServerVariablesPath, id)
+}
@@ -8,7 +8,6 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"time"
 )
 
 func getJson(ctx context.Context, client Client, path string, r interface{}) error {
@@ -94,69 +93,48 @@ func buildRequest(ctx context.Context, client Client, method, path string, body
 }
 
 func request(ctx context.Context, client Client, method, path string, body interface{}) (*http.Response, error) {
-	// Enhanced retry configuration for NTLM authentication issues
-	const maxRetries = 5
-	const initialBackoff = 1000 * time.Millisecond
-	
-	var response *http.Response
-	var err error
-	
-	for attempt := 0; attempt < maxRetries; attempt++ {
-		if attempt > 0 {
-			// Exponential backoff: 1s, 2s, 4s, 8s, 16s
-			backoff := initialBackoff * time.Duration(1<<uint(attempt-1))
-			time.Sleep(backoff)
-		}
-		
+	policy := client.retryPolicy()
+
+	for attempt := 1; ; attempt++ {
 		// Build a fresh request for each attempt (important for NTLM and body reuse)
 		req, err := buildRequest(ctx, client, method, path, body)
 		if err != nil {
 			return nil, err
 		}
-		
-		response, err = client.HttpClient.Do(req)
+
+		response, err := client.HttpClient.Do(req)
 		if err != nil {
-			// Network errors - retry
-			if attempt < maxRetries-1 {
-				continue
+			// Network errors - retry unless the request may have been processed already
+			if ctx.Err() != nil || !policy.retryNetworkError(method) || !policy.canRetry(attempt) {
+				return nil, err
 			}
-			return nil, err
+			if err := sleepContext(ctx, policy.backoff(attempt, 0)); err != nil {
+				return nil, fmt.Errorf("%s %s: retry aborted: %w", method, req.URL, err)
+			}
+			continue
 		}
-		
+
 		// Check if we should retry based on status code
-		if shouldRetry(response.StatusCode) && attempt < maxRetries-1 {
+		if policy.retryStatusCode(method, response.StatusCode) && policy.canRetry(attempt) {
+			delay := policy.backoff(attempt, parseRetryAfter(response))
 			// Close the response body before retrying
 			if response.Body != nil {
 				response.Body.Close()
 			}
+			if err := sleepContext(ctx, delay); err != nil {
+				return nil, fmt.Errorf("%s %s: retry aborted after %s: %w", method, req.URL, response.Status, err)
+			}
 			continue
 		}
-		
+
 		// Check status code before returning
 		if err := guardStatusCode(method, req.URL, response); err != nil {
-			// If this is a retryable error and we have retries left, continue
-			if shouldRetry(response.StatusCode) && attempt < maxRetries-1 {
-				if response.Body != nil {
-					response.Body.Close()
-				}
-				continue
-			}
 			return nil, err
 		}
-		
+
 		// Success!
 		return response, nil
 	}
-	
-	return response, err
-}
-
-// shouldRetry determines if a request should be retried based on status code
-func shouldRetry(statusCode int) bool {
-	// Retry on authentication failures (401), server errors (5xx), and too many requests (429)
-	// These are common with NTLM authentication issues and transient server problems
-	// Also retry on 403 as NTLM can sometimes return this during negotiation
-	return statusCode == 401 || statusCode == 403 || statusCode == 429 || (statusCode >= 500 && statusCode < 600)
 }
 
 func executeRequest(client Client, req *http.Request) (*http.Response, error) {
@@ -183,27 +161,8 @@ func fetchBody(res *http.Response) ([]byte, error) {
 
 func guardStatusCode(method string, url *url.URL, response *http.Response) error {
 	if response.StatusCode < 200 || response.StatusCode >= 400 {
-		var body string
-		if buffer, err := fetchBody(response); err == nil {
-			body = string(buffer[:])
-		}
-		return fmt.Errorf("%s %s returned invalid status code: %s\n%s", method, url, response.Status, body)
+		body, _ := fetchBody(response)
+		return newAPIError(method, url.String(), response, body)
 	}
 	return nil
 }
-
-// IsConflictError checks if an error is a 409 Conflict error
-func IsConflictError(err error) bool {
-	if err == nil {
-		return false
-	}
-	return bytes.Contains([]byte(err.Error()), []byte("409 Conflict"))
-}
-
-// IsNotFoundError checks if an error is a 404 Not Found error
-func IsNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
-	return bytes.Contains([]byte(err.Error()), []byte("404 Not Found"))
-}
@@ -0,0 +1,66 @@
+package iis
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type VirtualDirectory struct {
+	Location     string                   `json:"location"`
+	Path         string                   `json:"path"`
+	ID           string                   `json:"id"`
+	PhysicalPath string                   `json:"physical_path"`
+	Identity     VirtualDirectoryIdentity `json:"identity"`
+	Website      ApplicationReference     `json:"website"`
+	Application  WebappReference          `json:"webapp"`
+	Links        ResourceReferences       `json:"_links,omitempty"`
+}
+
+// VirtualDirectoryIdentity is the connect-as user used to access the physical path
+type VirtualDirectoryIdentity struct {
+	Username    string `json:"username"`
+	LogonMethod string `json:"logon_method,omitempty"`
+	// Password is never returned by the API and only sent if set
+	Password string `json:"password,omitempty"`
+}
+
+type WebappReference struct {
+	Location string `json:"location"`
+	Path     string `json:"path"`
+	ID       string `json:"id"`
+}
+
+type VirtualDirectoryListResponse struct {
+	VirtualDirectories []VirtualDirectory `json:"virtual_directories"`
+}
+
+func (client Client) ReadVirtualDirectory(ctx context.Context, id string) (*VirtualDirectory, error) {
+	url := fmt.Sprintf("/api/webserver/virtual-directories/%s", id)
+	var vdir VirtualDirectory
+	if err := getJson(ctx, client, url, &vdir); err != nil {
+		return nil, err
+	}
+	return &vdir, nil
+}
+
+// ListVirtualDirectories lists the virtual directories of a website or, if
+// applicationID is set, of an application
+func (client Client) ListVirtualDirectories(ctx context.Context, websiteID, applicationID string) ([]VirtualDirectory, error) {
+	query := url.Values{"fields": {"*"}}
+	if applicationID != "" {
+		query.Set("webapp.id", applicationID)
+	} else {
+		query.Set("website.id", websiteID)
+	}
+	var res VirtualDirectoryListResponse
+	if err := getJson(ctx, client, "/api/webserver/virtual-directories?"+query.Encode(), &res); err != nil {
+		return nil, err
+	}
+	return res.VirtualDirectories, nil
+}
+
+func (client Client) DeleteVirtualDirectory(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/api/webserver/virtual-directories/%s", id)
+	return httpDelete(ctx, client, url)
+}
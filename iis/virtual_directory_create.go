@@ -0,0 +1,28 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+)
+
+func (client Client) CreateVirtualDirectory(ctx context.Context, request CreateVirtualDirectoryRequest) (*VirtualDirectory, error) {
+	res, err := httpPost(ctx, client, "/api/webserver/virtual-directories", request)
+	if err != nil {
+		return nil, err
+	}
+	var vdir VirtualDirectory
+	if err := json.Unmarshal(res, &vdir); err != nil {
+		return nil, err
+	}
+	return &vdir, nil
+}
+
+// CreateVirtualDirectoryRequest creates a virtual directory below the root
+// application of a website or below an application
+type CreateVirtualDirectoryRequest struct {
+	Path         string                    `json:"path"`
+	PhysicalPath string                    `json:"physical_path"`
+	Website      *Reference                `json:"website,omitempty"`
+	Application  *Reference                `json:"webapp,omitempty"`
+	Identity     *VirtualDirectoryIdentity `json:"identity,omitempty"`
+}
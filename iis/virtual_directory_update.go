@@ -0,0 +1,26 @@
+package iis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+func (client Client) UpdateVirtualDirectory(ctx context.Context, id string, request UpdateVirtualDirectoryRequest) (*VirtualDirectory, error) {
+	url := fmt.Sprintf("/api/webserver/virtual-directories/%s", id)
+	res, err := httpPatch(ctx, client, url, request)
+	if err != nil {
+		return nil, err
+	}
+	var vdir VirtualDirectory
+	if err := json.Unmarshal(res, &vdir); err != nil {
+		return nil, err
+	}
+	return &vdir, nil
+}
+
+type UpdateVirtualDirectoryRequest struct {
+	Path         string                    `json:"path,omitempty"`
+	PhysicalPath string                    `json:"physical_path,omitempty"`
+	Identity     *VirtualDirectoryIdentity `json:"identity,omitempty"`
+}
@@ -1,35 +1,32 @@
 package provider
 
 import (
-	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
 )
 
-func testAccPreCheck(t *testing.T) {
-	if v := os.Getenv("IIS_HOST"); v == "" {
-		t.Skip("IIS_HOST must be set for acceptance tests")
-	}
-	if v := os.Getenv("IIS_ACCESS_KEY"); v == "" {
-		t.Skip("IIS_ACCESS_KEY must be set for acceptance tests")
-	}
-}
-
 func TestAccDataSourceIisCertificates_basic(t *testing.T) {
+	server := testAccServer(t)
+	certificate := server.AddCertificate(iis.Certificate{
+		Alias:      "example.com",
+		IssuedBy:   "CN=Example CA",
+		Subject:    "CN=example.com",
+		Thumbprint: "0123456789ABCDEF0123456789ABCDEF01234567",
+	})
+
 	resource.Test(t, resource.TestCase{
-		PreCheck: func() { testAccPreCheck(t) },
-		ProviderFactories: map[string]func() (*schema.Provider, error){
-			"iis": func() (*schema.Provider, error) {
-				return Provider(), nil
-			},
-		},
+		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDataSourceIisCertificatesConfig,
+				Config: testAccProviderConfig(server) + testAccDataSourceIisCertificatesConfig,
 				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttrSet("data.iis_certificates.test", "certificates.#"),
+					resource.TestCheckResourceAttr("data.iis_certificates.test", "certificates.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.iis_certificates.test", "certificates.*", map[string]string{
+						"id":         certificate.ID,
+						"thumbprint": certificate.Thumbprint,
+					}),
 				),
 			},
 		},
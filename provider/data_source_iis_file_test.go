@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceIisFile_basic(t *testing.T) {
+	server := testAccServer(t)
+	root := server.RootDirectory()
+	file := server.AddFile(root.ID, "index.html", "file")
+	server.AddFile(root.ID, "assets", "directory")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+data "iis_file" "test" {
+  parent_id = %q
+}
+`, root.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.iis_file.test", "files.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.iis_file.test", "files.*", map[string]string{
+						"id":   file.ID,
+						"type": "file",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func dataSourceIisHandlerMappings() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIisHandlerMappingsRead,
+		Schema: map[string]*schema.Schema{
+			WebsiteKey: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{ApplicationKey},
+				Description:   "List the handler mappings of this website, the server mappings are listed if neither website nor application is set",
+			},
+			ApplicationKey: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{WebsiteKey},
+				Description:   "List the handler mappings of this application",
+			},
+			"handler_mappings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Handler mappings in the order they are evaluated, including inherited mappings",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"verbs": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"modules": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"script_processor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"require_access": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"precondition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIisHandlerMappingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	handlers, err := client.ReadHttpHandlers(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mappings, err := client.ListHandlerMappings(ctx, handlers.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mappingList := make([]map[string]interface{}, 0, len(mappings))
+	for _, mapping := range mappings {
+		mappingList = append(mappingList, map[string]interface{}{
+			"id":               mapping.ID,
+			"name":             mapping.Name,
+			"path":             mapping.Path,
+			"verbs":            mapping.Verbs,
+			"type":             mapping.Type,
+			"modules":          mapping.Modules,
+			"script_processor": mapping.ScriptProcessor,
+			"resource_type":    mapping.ResourceType,
+			"require_access":   mapping.RequireAccess,
+			"precondition":     mapping.Precondition,
+		})
+	}
+
+	d.SetId(handlers.ID)
+	if err := d.Set("handler_mappings", mappingList); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func dataSourceIisModules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIisModulesRead,
+		Schema: map[string]*schema.Schema{
+			WebsiteKey: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{ApplicationKey},
+				Description:   "List the modules of this website, the server modules are listed if neither website nor application is set",
+			},
+			ApplicationKey: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{WebsiteKey},
+				Description:   "List the modules of this application",
+			},
+			"modules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Modules enabled for the scope, including inherited modules",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"precondition": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIisModulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	httpModules, err := client.ReadHttpModules(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	modules, err := client.ListModules(ctx, httpModules.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	moduleList := make([]map[string]interface{}, 0, len(modules))
+	for _, module := range modules {
+		moduleList = append(moduleList, map[string]interface{}{
+			"id":           module.ID,
+			"name":         module.Name,
+			"type":         module.Type,
+			"precondition": module.Precondition,
+		})
+	}
+
+	d.SetId(httpModules.ID)
+	if err := d.Set("modules", moduleList); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
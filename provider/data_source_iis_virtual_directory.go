@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func dataSourceIisVirtualDirectory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIisVirtualDirectoryRead,
+		Schema: map[string]*schema.Schema{
+			WebsiteKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{WebsiteKey, ApplicationKey},
+				Description:  "List the virtual directories of the root application of this website",
+			},
+			ApplicationKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{WebsiteKey, ApplicationKey},
+				Description:  "List the virtual directories of this application",
+			},
+			PathKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter virtual directories by path. If not specified, all virtual directories are returned.",
+			},
+			"virtual_directories": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIisVirtualDirectoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	websiteID := d.Get(WebsiteKey).(string)
+	applicationID := d.Get(ApplicationKey).(string)
+
+	vdirs, err := client.ListVirtualDirectories(ctx, websiteID, applicationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pathFilter := d.Get(PathKey).(string)
+	vdirList := make([]map[string]interface{}, 0)
+	for _, vdir := range vdirs {
+		if pathFilter != "" && vdir.Path != pathFilter {
+			continue
+		}
+		// Only the virtual directories of the root application belong to the website
+		if applicationID == "" && vdir.Application.Path != "/" && vdir.Application.Path != "" {
+			continue
+		}
+		vdirList = append(vdirList, map[string]interface{}{
+			"id":            vdir.ID,
+			"path":          vdir.Path,
+			"physical_path": vdir.PhysicalPath,
+			"location":      vdir.Location,
+			"username":      vdir.Identity.Username,
+		})
+	}
+
+	d.SetId(websiteID + applicationID)
+	if err := d.Set("virtual_directories", vdirList); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
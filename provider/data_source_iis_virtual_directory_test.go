@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func TestAccDataSourceIisVirtualDirectory_basic(t *testing.T) {
+	server := testAccServer(t)
+	client := server.Client()
+	ctx := context.Background()
+	site, err := client.CreateWebsite(ctx, iis.CreateWebsiteRequest{
+		Name:         "test-site",
+		PhysicalPath: `C:\inetpub\wwwroot`,
+		Bindings:     []iis.WebsiteBinding{{Protocol: "http", Port: 80, IPAddress: "*"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{"/images", "/scripts"} {
+		_, err := client.CreateVirtualDirectory(ctx, iis.CreateVirtualDirectoryRequest{
+			Path:         path,
+			PhysicalPath: `C:\content\` + path[1:],
+			Website:      &iis.Reference{ID: site.ID},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "iis_virtual_directory" "all" {
+  website = "` + site.ID + `"
+}
+
+data "iis_virtual_directory" "filtered" {
+  website = "` + site.ID + `"
+  path    = "/scripts"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.iis_virtual_directory.all", "virtual_directories.#", "2"),
+					resource.TestCheckResourceAttr("data.iis_virtual_directory.filtered", "virtual_directories.#", "1"),
+					resource.TestCheckResourceAttr("data.iis_virtual_directory.filtered", "virtual_directories.0.physical_path", `C:\content\scripts`),
+				),
+			},
+		},
+	})
+}
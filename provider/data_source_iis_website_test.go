@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func TestAccDataSourceIisWebsite_basic(t *testing.T) {
+	server := testAccServer(t)
+	client := server.Client()
+	for _, name := range []string{"site-a", "site-b"} {
+		_, err := client.CreateWebsite(context.Background(), iis.CreateWebsiteRequest{
+			Name:         name,
+			PhysicalPath: `C:\inetpub\` + name,
+			Bindings:     []iis.WebsiteBinding{{Protocol: "http", Port: 80, IPAddress: "*", Hostname: name}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+data "iis_website" "all" {}
+
+data "iis_website" "filtered" {
+  name = "site-b"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.iis_website.all", "websites.#", "2"),
+					resource.TestCheckResourceAttr("data.iis_website.filtered", "websites.#", "1"),
+					resource.TestCheckResourceAttr("data.iis_website.filtered", "websites.0.physical_path", `C:\inetpub\site-b`),
+				),
+			},
+		},
+	})
+}
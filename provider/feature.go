@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// withFeatureScope adds the website and application a feature is configured for to the schema
+func withFeatureScope(schemas map[string]*schema.Schema) map[string]*schema.Schema {
+	schemas[WebsiteKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{WebsiteKey, ApplicationKey},
+		Description:  "ID of the website the settings are configured for",
+	}
+	schemas[ApplicationKey] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{WebsiteKey, ApplicationKey},
+		Description:  "ID of the application the settings are configured for",
+	}
+	return schemas
+}
+
+// withServerFeatureScope adds the website and application a feature is
+// configured for to the schema, the feature is configured at server level if neither is set
+func withServerFeatureScope(schemas map[string]*schema.Schema) map[string]*schema.Schema {
+	schemas[WebsiteKey] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{ApplicationKey},
+		Description:   "ID of the website the settings are configured for, the server settings are managed if neither website nor application is set",
+	}
+	schemas[ApplicationKey] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{WebsiteKey},
+		Description:   "ID of the application the settings are configured for",
+	}
+	return schemas
+}
+
+// isServerScope returns whether the settings are configured at server level
+func isServerScope(d *schema.ResourceData) bool {
+	scope := getFeatureScope(d)
+	return scope.WebsiteID == "" && scope.ApplicationID == ""
+}
+
+func getFeatureScope(d *schema.ResourceData) iis.Scope {
+	// Features which are only configured for websites, like logging, have no application
+	applicationID, _ := d.Get(ApplicationKey).(string)
+	return iis.Scope{
+		WebsiteID:     d.Get(WebsiteKey).(string),
+		ApplicationID: applicationID,
+	}
+}
+
+// setFeatureScope sets the website or application of an imported feature,
+// the configured scope is kept otherwise
+func setFeatureScope(ctx context.Context, d *schema.ResourceData, client *iis.Client, feature iis.Feature) error {
+	if scope := getFeatureScope(d); scope.WebsiteID != "" || scope.ApplicationID != "" {
+		return nil
+	}
+	if feature.Website == nil || feature.Website.ID == "" {
+		return fmt.Errorf("%s is configured at server level, only website or application settings can be managed", d.Id())
+	}
+	location := strings.TrimSuffix(feature.Scope, "/")
+	if location == feature.Website.Name {
+		return d.Set(WebsiteKey, feature.Website.ID)
+	}
+	applications, err := client.ListApplications(ctx, feature.Website.ID)
+	if err != nil {
+		return err
+	}
+	for _, application := range applications {
+		if application.Location == location {
+			return d.Set(ApplicationKey, application.ID)
+		}
+	}
+	return fmt.Errorf("no application found for scope %q", feature.Scope)
+}
+
+// setServerFeatureScope is setFeatureScope for features which can be managed
+// at server level, an imported server level feature has no website or application
+func setServerFeatureScope(ctx context.Context, d *schema.ResourceData, client *iis.Client, feature iis.Feature) error {
+	if feature.Website == nil || feature.Website.ID == "" {
+		return nil
+	}
+	return setFeatureScope(ctx, d, client, feature)
+}
+
+// setEntryFeatureScope sets the website or application of an imported feature
+// entry from its feature, entries of a server level feature have neither
+func setEntryFeatureScope(ctx context.Context, d *schema.ResourceData, client *iis.Client, parent *iis.Reference, read func(id string) (*iis.Feature, error)) error {
+	if !isServerScope(d) {
+		return nil
+	}
+	if parent == nil {
+		return fmt.Errorf("%s does not reference its feature", d.Id())
+	}
+	feature, err := read(parent.ID)
+	if err != nil {
+		return err
+	}
+	return setServerFeatureScope(ctx, d, client, *feature)
+}
+
+// withLockedSectionDiagnostics explains the errors of writes to configuration
+// sections which are locked at a parent scope for all resources
+func withLockedSectionDiagnostics(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, resource := range resources {
+		resource.CreateContext = explainLockedSection(resource.CreateContext)
+		resource.UpdateContext = explainLockedSection(resource.UpdateContext)
+		resource.DeleteContext = explainLockedSection(resource.DeleteContext)
+	}
+	return resources
+}
+
+// explainLockedSection adds how to unlock the configuration section to the
+// diagnostics of a write which failed because the section is locked
+func explainLockedSection[F ~func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics](f F) F {
+	if f == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		for i := range diags {
+			if diags[i].Severity == diag.Error && diags[i].Detail == "" && strings.Contains(diags[i].Summary, iis.ErrSectionLocked.Error()) {
+				diags[i].Detail = "Set the override mode of the section to allow at the parent scope, e.g. with an " +
+					"iis_feature_delegation resource, or configure the settings at the scope which locks them."
+			}
+		}
+		return diags
+	}
+}
+
+// getPriority returns the configured priority of an ordered entry if it has to
+// be sent, which moves the entry within its feature
+func getPriority(d *schema.ResourceData) *int {
+	if !isConfigured(d, "priority") || !(d.IsNewResource() || d.HasChange("priority")) {
+		return nil
+	}
+	priority := d.Get("priority").(int)
+	return &priority
+}
+
+// flattenPriority returns the position of an ordered entry
+func flattenPriority(priority *int) int {
+	if priority == nil {
+		return 0
+	}
+	return *priority
+}
+
+// featureEntries reconciles the entries of a feature collection, e.g. the custom
+// headers of the response headers, which are identified by a unique key
+type featureEntries[E any] struct {
+	key    func(E) string
+	id     func(E) string
+	equal  func(a, b E) bool
+	create func(entry E) error
+	// update changes an entry in place, entries are recreated if nil
+	update func(id string, entry E) error
+	remove func(id string) error
+}
+
+func entriesByKey[E any](entries []E, key func(E) string) map[string]E {
+	byKey := make(map[string]E, len(entries))
+	for _, entry := range entries {
+		byKey[strings.ToLower(key(entry))] = entry
+	}
+	return byKey
+}
+
+// reconcile creates, updates and removes entries to match the desired entries.
+// Entries which are neither desired nor were managed before are only removed in authoritative mode.
+func (e featureEntries[E]) reconcile(current, managed, desired []E, authoritative bool) error {
+	wasManaged := entriesByKey(managed, e.key)
+	wanted := entriesByKey(desired, e.key)
+	existing := make(map[string]bool)
+	for _, entry := range current {
+		key := strings.ToLower(e.key(entry))
+		want, ok := wanted[key]
+		_, managed := wasManaged[key]
+		switch {
+		case ok && e.equal(want, entry):
+			existing[key] = true
+		case ok && e.update != nil:
+			existing[key] = true
+			if err := e.update(e.id(entry), want); err != nil {
+				return err
+			}
+		case ok || authoritative || managed:
+			if err := e.remove(e.id(entry)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, entry := range desired {
+		if existing[strings.ToLower(e.key(entry))] {
+			continue
+		}
+		if err := e.create(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// filter returns the entries which are managed by the resource, all entries in authoritative mode
+func (e featureEntries[E]) filter(current, managed []E, authoritative bool) []E {
+	if authoritative {
+		return current
+	}
+	wasManaged := entriesByKey(managed, e.key)
+	filtered := make([]E, 0, len(current))
+	for _, entry := range current {
+		if _, ok := wasManaged[strings.ToLower(e.key(entry))]; ok {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
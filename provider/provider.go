@@ -61,20 +61,51 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("IIS_NTLM_DOMAIN", nil),
 				Description: "Domain for NTLM authentication. Can also be sourced from the IIS_NTLM_DOMAIN environment variable. Optional, can be empty for local accounts.",
 			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Retry policy for failed requests against the IIS Administration API.",
+				Elem:        retrySchema,
+			},
 		},
-		ResourcesMap: map[string]*schema.Resource{
-			"iis_application_pool": resourceApplicationPool(),
-			"iis_application":      resourceApplication(),
-			"iis_authentication":   resourceAuthentication(),
-			"iis_website":          resourceWebsite(),
-			"iis_directory":        resourceDirectory(),
-			"iis_file_copy":        resourceFileCopy(),
-			"iis_api_token":        resourceApiToken(),
-		},
+		ResourcesMap: withLockedSectionDiagnostics(map[string]*schema.Resource{
+			"iis_application_pool":                     resourceApplicationPool(),
+			"iis_application":                          resourceApplication(),
+			"iis_authentication":                       resourceAuthentication(),
+			"iis_website":                              resourceWebsite(),
+			"iis_virtual_directory":                    resourceVirtualDirectory(),
+			"iis_default_document":                     resourceDefaultDocument(),
+			"iis_http_response_headers":                resourceHttpResponseHeaders(),
+			"iis_request_filtering":                    resourceRequestFiltering(),
+			"iis_ip_restrictions":                      resourceIPRestrictions(),
+			"iis_authorization_rules":                  resourceAuthorizationRules(),
+			"iis_url_rewrite_inbound_rule":             resourceUrlRewriteInboundRule(),
+			"iis_url_rewrite_outbound_rule":            resourceUrlRewriteOutboundRule(),
+			"iis_url_rewrite_map":                      resourceUrlRewriteMap(),
+			"iis_url_rewrite_allowed_server_variables": resourceUrlRewriteAllowedServerVariables(),
+			"iis_response_compression":                 resourceResponseCompression(),
+			"iis_site_logging":                         resourceSiteLogging(),
+			"iis_handler_mapping":                      resourceHandlerMapping(),
+			"iis_module":                               resourceModule(),
+			"iis_request_tracing":                      resourceRequestTracing(),
+			"iis_request_tracing_rule":                 resourceRequestTracingRule(),
+			"iis_ssl_settings":                         resourceSSLSettings(),
+			"iis_static_content":                       resourceStaticContent(),
+			"iis_directory_browsing":                   resourceDirectoryBrowsing(),
+			"iis_feature_delegation":                   resourceFeatureDelegation(),
+			"iis_config_section":                       resourceConfigSection(),
+			"iis_directory":                            resourceDirectory(),
+			"iis_file_copy":                            resourceFileCopy(),
+			"iis_api_token":                            resourceApiToken(),
+		}),
 		DataSourcesMap: map[string]*schema.Resource{
-			"iis_website":      dataSourceIisWebsite(),
-			"iis_certificates": dataSourceIisCertificates(),
-			"iis_file":         dataSourceIisFile(),
+			"iis_website":           dataSourceIisWebsite(),
+			"iis_certificates":      dataSourceIisCertificates(),
+			"iis_file":              dataSourceIisFile(),
+			"iis_virtual_directory": dataSourceIisVirtualDirectory(),
+			"iis_handler_mappings":  dataSourceIisHandlerMappings(),
+			"iis_modules":           dataSourceIisModules(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -161,12 +192,13 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		}
 	}
 
+	retryPolicy := expandRetryPolicy(getList(d, "retry"))
+
 	loggingTransport := logging.NewLoggingHTTPTransport(finalTransport)
 	client := &iis.Client{
 		HttpClient: http.Client{
 			Transport: loggingTransport,
-			// Increased timeout to accommodate retries
-			// Total time: 5 retries * max 16s backoff + 60s request time
+			// Timeout of a single attempt, retries are controlled by the retry policy
 			Timeout: 120 * time.Second,
 		},
 		Host:         host,
@@ -174,6 +206,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		NTLMUsername: ntlmUsername,
 		NTLMPassword: ntlmPassword,
 		NTLMDomain:   ntlmDomain,
+		RetryPolicy:  &retryPolicy,
 	}
 
 	// Auto-generate API token if only NTLM credentials are provided
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+	"github.com/maxjoehnk/terraform-provider-iis/iis/iistest"
+)
+
+var testAccProviderFactories = map[string]func() (*schema.Provider, error){
+	"iis": func() (*schema.Provider, error) {
+		return Provider(), nil
+	},
+}
+
+func TestProvider(t *testing.T) {
+	if err := Provider().InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// testAccServer starts a fake IIS Administration API for the duration of a test
+func testAccServer(t *testing.T) *iistest.Server {
+	server := iistest.NewServer()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testAccProviderConfig configures the provider to use the given fake server
+func testAccProviderConfig(server *iistest.Server) string {
+	return fmt.Sprintf(`
+provider "iis" {
+  host       = %q
+  access_key = %q
+}
+`, server.URL, server.AccessKey)
+}
+
+// testAccCheckDestroy verifies that read reports every resource of the given type as not found
+func testAccCheckDestroy(server *iistest.Server, resourceType string, read func(ctx context.Context, client *iis.Client, id string) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := server.Client()
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			if err := read(context.Background(), client, rs.Primary.ID); !iis.IsNotFoundError(err) {
+				return fmt.Errorf("%s %s still exists: %v", resourceType, rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
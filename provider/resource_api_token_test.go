@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceApiToken_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "iis_api_token" "test" {
+  host          = %q
+  ntlm_username = "administrator"
+  ntlm_password = "secret"
+}
+`, server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_api_token.test", "id", server.URL),
+					resource.TestCheckResourceAttrWith("iis_api_token.test", "access_token", func(value string) error {
+						if len(value) != 54 {
+							return fmt.Errorf("expected 54 character token, got %d", len(value))
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
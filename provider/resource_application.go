@@ -56,7 +56,7 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, m in
 	}
 	tflog.Debug(ctx, "Created application: "+toJSON(application))
 	d.SetId(application.ID)
-	return nil
+	return resourceApplicationRead(ctx, d, m)
 }
 
 func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
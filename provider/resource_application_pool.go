@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"sort"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/maxjoehnk/terraform-provider-iis/iis"
 )
 
@@ -24,14 +27,14 @@ func resourceApplicationPool() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			NameKey: {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true, // Renaming requires recreate
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true, // Renaming requires recreate
 			},
 			StatusKey: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "started",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "started",
 			},
 			"managed_runtime_version": {
 				Type:        schema.TypeString,
@@ -39,10 +42,312 @@ func resourceApplicationPool() *schema.Resource {
 				Default:     "v4.0",
 				Description: ".NET CLR version for the app pool (e.g., v4.0, v2.0)",
 			},
+			"auto_start": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Start the app pool automatically when IIS starts",
+			},
+			"start_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OnDemand", "AlwaysRunning"}, false),
+				Description:  "Start a worker process on demand or keep one always running (AlwaysRunning) to avoid cold starts",
+			},
+			"pipeline_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"integrated", "classic"}, false),
+				Description:  "Managed pipeline mode: integrated, classic",
+			},
+			"enable_32bit_win64": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Run 32-bit applications on 64-bit Windows",
+			},
+			"queue_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(10, 65535),
+				Description:  "Maximum number of requests queued for the app pool",
+			},
+			"cpu": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     appPoolCPUSchema,
+			},
+			"process_model": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     appPoolProcessModelSchema,
+			},
+			"identity": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        appPoolIdentitySchema,
+				Description: "Identity of the worker processes, it is only read back if configured as it contains the write-only password",
+			},
+			"recycling": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     appPoolRecyclingSchema,
+			},
+			"rapid_fail_protection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     appPoolRapidFailProtectionSchema,
+			},
+			"process_orphaning": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     appPoolProcessOrphaningSchema,
+			},
 		},
 	}
 }
 
+var appPoolCPUSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"limit": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(0, 100000),
+			Description:  "Maximum CPU usage in 1/1000ths of a percent, 0 disables the limit",
+		},
+		"limit_interval": timeSpanSchema("Interval in which the CPU limit is monitored (e.g. 5m)"),
+		"action": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"NoAction", "KillW3wp", "Throttle", "ThrottleUnderLoad"}, false),
+			Description:  "Action taken when the CPU limit is exceeded: NoAction, KillW3wp, Throttle, ThrottleUnderLoad",
+		},
+		"processor_affinity_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"processor_affinity_mask32": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"processor_affinity_mask64": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	},
+}
+
+var appPoolProcessModelSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"max_processes": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Maximum number of worker processes (web garden)",
+		},
+		"pinging_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"idle_timeout":        timeSpanSchema("Idle time after which a worker process is shut down, 0 disables the timeout (e.g. 20m)"),
+		"ping_interval":       timeSpanSchema("Interval between health monitoring pings (e.g. 30s)"),
+		"ping_response_time":  timeSpanSchema("Maximum time a worker process has to respond to a ping (e.g. 90s)"),
+		"shutdown_time_limit": timeSpanSchema("Time a worker process is given to finish requests before it is shut down (e.g. 90s)"),
+		"startup_time_limit":  timeSpanSchema("Time a worker process is given to start (e.g. 90s)"),
+		"idle_timeout_action": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"Terminate", "Suspend"}, false),
+			Description:  "Action taken when the idle timeout is reached: Terminate, Suspend",
+		},
+	},
+}
+
+var appPoolIdentitySchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"ApplicationPoolIdentity", "LocalSystem", "LocalService", "NetworkService", "SpecificUser"}, false),
+			Description:  "Identity the worker process runs as: ApplicationPoolIdentity, LocalSystem, LocalService, NetworkService, SpecificUser",
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"load_user_profile": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"password": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			WriteOnly:   true,
+			Description: "Password of the SpecificUser identity, it is never stored in state and only sent on create or when password_version changes",
+		},
+		"password_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Arbitrary value (e.g. a counter or hash) which has to be changed to send a new password",
+		},
+	},
+}
+
+var appPoolRecyclingSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"disable_overlapped_recycle": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"disable_recycle_on_config_change": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"private_memory": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Recycle after the private memory exceeds this amount of KB, 0 disables the limit",
+		},
+		"virtual_memory": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Recycle after the virtual memory exceeds this amount of KB, 0 disables the limit",
+		},
+		"request_limit": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Recycle after this number of requests, 0 disables the limit",
+		},
+		"time_interval": timeSpanSchema("Recycle the app pool in this interval, 0 disables periodic recycling (e.g. 29h or PT1740M)"),
+		"schedule": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Times of day in 24-hour hh:mm notation at which the app pool is recycled",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validateTimeOfDay,
+			},
+			Set: hashTimeOfDay,
+		},
+		"log_events": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time":            {Type: schema.TypeBool, Optional: true, Computed: true},
+					"requests":        {Type: schema.TypeBool, Optional: true, Computed: true},
+					"schedule":        {Type: schema.TypeBool, Optional: true, Computed: true},
+					"memory":          {Type: schema.TypeBool, Optional: true, Computed: true},
+					"isapi_unhealthy": {Type: schema.TypeBool, Optional: true, Computed: true},
+					"on_demand":       {Type: schema.TypeBool, Optional: true, Computed: true},
+					"config_change":   {Type: schema.TypeBool, Optional: true, Computed: true},
+					"private_memory":  {Type: schema.TypeBool, Optional: true, Computed: true},
+				},
+			},
+		},
+	},
+}
+
+var appPoolRapidFailProtectionSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"load_balancer_capabilities": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"HttpLevel", "TcpLevel"}, false),
+		},
+		"max_crashes": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Number of worker process crashes within the interval that disable the app pool",
+		},
+		"interval": timeSpanSchema("Interval in which max_crashes have to occur to disable the app pool (e.g. 5m)"),
+		"auto_shutdown_exe": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"auto_shutdown_params": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	},
+}
+
+var appPoolProcessOrphaningSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"orphan_action_exe": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"orphan_action_params": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	},
+}
+
+// timeSpanSchema is an optional duration which accepts Go ("20m") and ISO 8601 ("PT20M") notation
+func timeSpanSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ValidateFunc:     validateTimeSpan,
+		DiffSuppressFunc: suppressEquivalentTimeSpan,
+		Description:      description,
+	}
+}
+
 func resourceApplicationPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*iis.Client)
 	name := d.Get(NameKey).(string)
@@ -54,17 +359,12 @@ func resourceApplicationPoolCreate(ctx context.Context, d *schema.ResourceData,
 	}
 	tflog.Debug(ctx, "Created application pool: "+toJSON(pool))
 	d.SetId(pool.ID)
-	
-	// If user specified a status different from the created status, apply it
-	if status, ok := d.GetOk(StatusKey); ok && status.(string) != "" && status.(string) != pool.Status {
-		desiredStatus := status.(string)
-		tflog.Debug(ctx, "Setting app pool status to: "+desiredStatus)
-		_, err = client.UpdateAppPool(ctx, pool.ID, runtimeVersion, desiredStatus)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+
+	// Apply status and all configured settings which are not part of the create request
+	if diags := updateApplicationPool(ctx, d, client); diags.HasError() {
+		return diags
 	}
-	
+
 	return resourceApplicationPoolRead(ctx, d, m)
 }
 
@@ -83,39 +383,41 @@ func resourceApplicationPoolRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	tflog.Debug(ctx, "Read application pool: "+toJSON(appPool))
 
-       if err = d.Set(NameKey, appPool.Name); err != nil {
-	       return diag.FromErr(err)
-       }
-       if err = d.Set(StatusKey, appPool.Status); err != nil {
-	       return diag.FromErr(err)
-       }
-       if err = d.Set("managed_runtime_version", appPool.ManagedRuntimeVersion); err != nil {
-	       return diag.FromErr(err)
-       }
+	values := map[string]interface{}{
+		NameKey:                   appPool.Name,
+		StatusKey:                 appPool.Status,
+		"managed_runtime_version": appPool.ManagedRuntimeVersion,
+		"auto_start":              appPool.AutoStart,
+		"start_mode":              appPool.StartMode,
+		"pipeline_mode":           appPool.PipelineMode,
+		"enable_32bit_win64":      appPool.Enable32BitWin64,
+		"queue_length":            int(appPool.QueueLength),
+		"cpu":                     flattenAppPoolCPU(appPool.CPU),
+		"process_model":           flattenAppPoolProcessModel(appPool.ProcessModel),
+		"recycling":               flattenAppPoolRecycling(appPool.Recycling),
+		"rapid_fail_protection":   flattenAppPoolRapidFailProtection(appPool.RapidFailProtection),
+		"process_orphaning":       flattenAppPoolProcessOrphaning(appPool.ProcessOrphaning),
+	}
+	if hasNestedMap(d, "identity") {
+		values["identity"] = flattenAppPoolIdentity(appPool.Identity, d.Get("identity.0.password_version").(string))
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 	return nil
 }
 
 func resourceApplicationPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*iis.Client)
-	id := d.Id()
-	
-	if d.HasChange("managed_runtime_version") || d.HasChange(StatusKey) {
-		runtimeVersion := d.Get("managed_runtime_version").(string)
-		status := d.Get(StatusKey).(string)
-		tflog.Debug(ctx, "Updating application pool: "+toJSON(id)+", runtime: "+runtimeVersion+", status: "+status)
-		
-		applicationPool, err := client.UpdateAppPool(ctx, id, runtimeVersion, status)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		
-		tflog.Debug(ctx, "Updated application pool: "+toJSON(applicationPool))
-		
-		// Re-read to update state
-		return resourceApplicationPoolRead(ctx, d, m)
+
+	if diags := updateApplicationPool(ctx, d, client); diags.HasError() {
+		return diags
 	}
-	
-	return nil
+
+	// Re-read to update state
+	return resourceApplicationPoolRead(ctx, d, m)
 }
 
 func resourceApplicationPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -129,3 +431,232 @@ func resourceApplicationPoolDelete(ctx context.Context, d *schema.ResourceData,
 	tflog.Debug(ctx, "Deleted application pool: "+toJSON(id))
 	return nil
 }
+
+// updateApplicationPool patches the configured settings which changed, all
+// other settings are not sent and keep their value on the server
+func updateApplicationPool(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	appPool, err := client.ReadAppPool(ctx, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	request := expandAppPool(d, appPool)
+
+	// The password is write-only, so it is only sent when it is set initially or rotated
+	if d.IsNewResource() || d.HasChange("identity.0.password_version") {
+		password, diags := getWriteOnlyString(d, "identity.0.password")
+		if diags.HasError() {
+			return diags
+		}
+		if password != "" {
+			if request.Identity == nil {
+				request.Identity = &appPool.Identity
+			}
+			request.Identity.Password = password
+		}
+	}
+	tflog.Debug(ctx, "Updating application pool: "+toJSON(request))
+
+	appPool, err = client.UpdateAppPool(ctx, d.Id(), request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated application pool: "+toJSON(appPool))
+	return nil
+}
+
+// expandAppPool returns the update request of the changed settings. Nested
+// blocks are sent as a whole, their settings which are not configured keep
+// the current value of appPool.
+func expandAppPool(d *schema.ResourceData, appPool *iis.ApplicationPool) iis.UpdateAppPoolRequest {
+	request := iis.UpdateAppPoolRequest{
+		AutoStart:        getConfiguredChange[bool](d, "auto_start"),
+		StartMode:        getConfiguredChange[string](d, "start_mode"),
+		PipelineMode:     getConfiguredChange[string](d, "pipeline_mode"),
+		Enable32BitWin64: getConfiguredChange[bool](d, "enable_32bit_win64"),
+	}
+	// The status and runtime version have defaults, so they are sent even if not configured
+	if d.IsNewResource() || d.HasChange(StatusKey) {
+		status := d.Get(StatusKey).(string)
+		request.Status = &status
+	}
+	if d.HasChange("managed_runtime_version") {
+		runtimeVersion := d.Get("managed_runtime_version").(string)
+		request.ManagedRuntimeVersion = &runtimeVersion
+	}
+	if queueLength := getConfiguredChange[int](d, "queue_length"); queueLength != nil {
+		value := int64(*queueLength)
+		request.QueueLength = &value
+	}
+
+	if hasConfiguredChange(d, "cpu") {
+		cpu := appPool.CPU
+		setConfiguredInt64(d, "cpu.0.limit", &cpu.Limit)
+		setConfiguredTimeSpan(d, "cpu.0.limit_interval", (*time.Duration)(&cpu.LimitInterval))
+		setConfiguredString(d, "cpu.0.action", &cpu.Action)
+		setConfiguredBool(d, "cpu.0.processor_affinity_enabled", &cpu.ProcessorAffinityEnabled)
+		setConfiguredString(d, "cpu.0.processor_affinity_mask32", &cpu.ProcessorAffinityMask32)
+		setConfiguredString(d, "cpu.0.processor_affinity_mask64", &cpu.ProcessorAffinityMask64)
+		request.CPU = &cpu
+	}
+
+	if hasConfiguredChange(d, "process_model") {
+		processModel := appPool.ProcessModel
+		setConfiguredInt64(d, "process_model.0.max_processes", &processModel.MaxProcesses)
+		setConfiguredBool(d, "process_model.0.pinging_enabled", &processModel.PingingEnabled)
+		setConfiguredString(d, "process_model.0.idle_timeout_action", &processModel.IdleTimeoutAction)
+		setConfiguredTimeSpan(d, "process_model.0.idle_timeout", (*time.Duration)(&processModel.IdleTimeout))
+		setConfiguredTimeSpan(d, "process_model.0.ping_interval", (*time.Duration)(&processModel.PingInterval))
+		setConfiguredTimeSpan(d, "process_model.0.ping_response_time", (*time.Duration)(&processModel.PingResponseTime))
+		setConfiguredTimeSpan(d, "process_model.0.shutdown_time_limit", (*time.Duration)(&processModel.ShutdownTimeLimit))
+		setConfiguredTimeSpan(d, "process_model.0.startup_time_limit", (*time.Duration)(&processModel.StartupTimeLimit))
+		request.ProcessModel = &processModel
+	}
+
+	if hasConfiguredChange(d, "identity") {
+		identity := appPool.Identity
+		setConfiguredString(d, "identity.0.type", &identity.IdentityType)
+		setConfiguredString(d, "identity.0.username", &identity.Username)
+		setConfiguredBool(d, "identity.0.load_user_profile", &identity.LoadUserProfile)
+		request.Identity = &identity
+	}
+
+	if hasConfiguredChange(d, "recycling") {
+		recycling := iis.UpdateRecyclingRequest{Recycling: appPool.Recycling}
+		recycling.PeriodicRestart.PeriodicRestart = appPool.Recycling.PeriodicRestart
+		setConfiguredBool(d, "recycling.0.disable_overlapped_recycle", &recycling.DisableOverlappedRecycle)
+		setConfiguredBool(d, "recycling.0.disable_recycle_on_config_change", &recycling.DisableRecycleOnConfigChange)
+		setConfiguredInt64(d, "recycling.0.private_memory", &recycling.PeriodicRestart.PrivateMemory)
+		setConfiguredInt64(d, "recycling.0.virtual_memory", &recycling.PeriodicRestart.VirtualMemory)
+		setConfiguredInt64(d, "recycling.0.request_limit", &recycling.PeriodicRestart.RequestLimit)
+		setConfiguredTimeSpan(d, "recycling.0.time_interval", (*time.Duration)(&recycling.PeriodicRestart.TimeInterval))
+		if isConfigured(d, "recycling.0.schedule") {
+			schedule := expandAppPoolSchedule(d.Get("recycling.0.schedule").(*schema.Set))
+			recycling.PeriodicRestart.Schedule = &schedule
+		}
+		setConfiguredBool(d, "recycling.0.log_events.0.time", &recycling.LogEvents.Time)
+		setConfiguredBool(d, "recycling.0.log_events.0.requests", &recycling.LogEvents.Requests)
+		setConfiguredBool(d, "recycling.0.log_events.0.schedule", &recycling.LogEvents.Schedule)
+		setConfiguredBool(d, "recycling.0.log_events.0.memory", &recycling.LogEvents.Memory)
+		setConfiguredBool(d, "recycling.0.log_events.0.isapi_unhealthy", &recycling.LogEvents.IsapiUnhealthy)
+		setConfiguredBool(d, "recycling.0.log_events.0.on_demand", &recycling.LogEvents.OnDemand)
+		setConfiguredBool(d, "recycling.0.log_events.0.config_change", &recycling.LogEvents.ConfigChange)
+		setConfiguredBool(d, "recycling.0.log_events.0.private_memory", &recycling.LogEvents.PrivateMemory)
+		request.Recycling = &recycling
+	}
+
+	if hasConfiguredChange(d, "rapid_fail_protection") {
+		rapidFail := appPool.RapidFailProtection
+		setConfiguredBool(d, "rapid_fail_protection.0.enabled", &rapidFail.Enabled)
+		setConfiguredString(d, "rapid_fail_protection.0.load_balancer_capabilities", &rapidFail.LoadBalancerCapabilities)
+		setConfiguredInt64(d, "rapid_fail_protection.0.max_crashes", &rapidFail.MaxCrashes)
+		setConfiguredTimeSpan(d, "rapid_fail_protection.0.interval", (*time.Duration)(&rapidFail.Interval))
+		setConfiguredString(d, "rapid_fail_protection.0.auto_shutdown_exe", &rapidFail.AutoShutdownExe)
+		setConfiguredString(d, "rapid_fail_protection.0.auto_shutdown_params", &rapidFail.AutoShutdownParams)
+		request.RapidFailProtection = &rapidFail
+	}
+
+	if hasConfiguredChange(d, "process_orphaning") {
+		processOrphaning := appPool.ProcessOrphaning
+		setConfiguredBool(d, "process_orphaning.0.enabled", &processOrphaning.Enabled)
+		setConfiguredString(d, "process_orphaning.0.orphan_action_exe", &processOrphaning.OrphanActionExe)
+		setConfiguredString(d, "process_orphaning.0.orphan_action_params", &processOrphaning.OrphanActionParams)
+		request.ProcessOrphaning = &processOrphaning
+	}
+	return request
+}
+
+func flattenAppPoolCPU(cpu iis.CPU) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"limit":                      int(cpu.Limit),
+		"limit_interval":             iis.FormatTimeSpan(cpu.LimitInterval.Duration()),
+		"action":                     cpu.Action,
+		"processor_affinity_enabled": cpu.ProcessorAffinityEnabled,
+		"processor_affinity_mask32":  cpu.ProcessorAffinityMask32,
+		"processor_affinity_mask64":  cpu.ProcessorAffinityMask64,
+	}}
+}
+
+func flattenAppPoolProcessModel(processModel iis.ProcessModel) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"max_processes":       int(processModel.MaxProcesses),
+		"pinging_enabled":     processModel.PingingEnabled,
+		"idle_timeout":        iis.FormatTimeSpan(processModel.IdleTimeout.Duration()),
+		"ping_interval":       iis.FormatTimeSpan(processModel.PingInterval.Duration()),
+		"ping_response_time":  iis.FormatTimeSpan(processModel.PingResponseTime.Duration()),
+		"shutdown_time_limit": iis.FormatTimeSpan(processModel.ShutdownTimeLimit.Duration()),
+		"startup_time_limit":  iis.FormatTimeSpan(processModel.StartupTimeLimit.Duration()),
+		"idle_timeout_action": processModel.IdleTimeoutAction,
+	}}
+}
+
+// flattenAppPoolIdentity keeps the password version from the state, as neither
+// the password nor its version are returned by the API
+func flattenAppPoolIdentity(identity iis.Identity, passwordVersion string) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"type":              identity.IdentityType,
+		"username":          identity.Username,
+		"load_user_profile": identity.LoadUserProfile,
+		"password_version":  passwordVersion,
+	}}
+}
+
+func flattenAppPoolRecycling(recycling iis.Recycling) []interface{} {
+	logEvents := recycling.LogEvents
+	return []interface{}{map[string]interface{}{
+		"disable_overlapped_recycle":       recycling.DisableOverlappedRecycle,
+		"disable_recycle_on_config_change": recycling.DisableRecycleOnConfigChange,
+		"private_memory":                   int(recycling.PeriodicRestart.PrivateMemory),
+		"virtual_memory":                   int(recycling.PeriodicRestart.VirtualMemory),
+		"request_limit":                    int(recycling.PeriodicRestart.RequestLimit),
+		"time_interval":                    iis.FormatTimeSpan(recycling.PeriodicRestart.TimeInterval.Duration()),
+		"schedule":                         flattenAppPoolSchedule(recycling.PeriodicRestart.Schedule),
+		"log_events": []interface{}{map[string]interface{}{
+			"time":            logEvents.Time,
+			"requests":        logEvents.Requests,
+			"schedule":        logEvents.Schedule,
+			"memory":          logEvents.Memory,
+			"isapi_unhealthy": logEvents.IsapiUnhealthy,
+			"on_demand":       logEvents.OnDemand,
+			"config_change":   logEvents.ConfigChange,
+			"private_memory":  logEvents.PrivateMemory,
+		}},
+	}}
+}
+
+func expandAppPoolSchedule(set *schema.Set) []iis.TimeOfDay {
+	schedule := make([]iis.TimeOfDay, 0, set.Len())
+	for _, value := range set.List() {
+		// The values have already been checked by validateTimeOfDay
+		timeOfDay, _ := iis.ParseTimeOfDay(value.(string))
+		schedule = append(schedule, timeOfDay)
+	}
+	sort.Slice(schedule, func(i, j int) bool { return schedule[i] < schedule[j] })
+	return schedule
+}
+
+func flattenAppPoolSchedule(schedule []iis.TimeOfDay) []interface{} {
+	values := make([]interface{}, 0, len(schedule))
+	for _, timeOfDay := range schedule {
+		values = append(values, timeOfDay.String())
+	}
+	return values
+}
+
+func flattenAppPoolRapidFailProtection(rapidFail iis.RapidFailProtection) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"enabled":                    rapidFail.Enabled,
+		"load_balancer_capabilities": rapidFail.LoadBalancerCapabilities,
+		"max_crashes":                int(rapidFail.MaxCrashes),
+		"interval":                   iis.FormatTimeSpan(rapidFail.Interval.Duration()),
+		"auto_shutdown_exe":          rapidFail.AutoShutdownExe,
+		"auto_shutdown_params":       rapidFail.AutoShutdownParams,
+	}}
+}
+
+func flattenAppPoolProcessOrphaning(processOrphaning iis.ProcessOrphaning) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"enabled":              processOrphaning.Enabled,
+		"orphan_action_exe":    processOrphaning.OrphanActionExe,
+		"orphan_action_params": processOrphaning.OrphanActionParams,
+	}}
+}
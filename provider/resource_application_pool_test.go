@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+	"github.com/maxjoehnk/terraform-provider-iis/iis/iistest"
+)
+
+func TestAccResourceApplicationPool_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: testAccCheckDestroy(server, "iis_application_pool", func(ctx context.Context, client *iis.Client, id string) error {
+			_, err := client.ReadAppPool(ctx, id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceApplicationPoolConfig("started"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_application_pool.test", "name", "test-pool"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "status", "started"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "managed_runtime_version", "v4.0"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceApplicationPoolConfig("stopped"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_application_pool.test", "status", "stopped"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceApplicationPoolSettingsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_application_pool.test", "pipeline_mode", "classic"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "enable_32bit_win64", "true"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "start_mode", "AlwaysRunning"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "cpu.0.limit", "50000"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "cpu.0.action", "Throttle"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "rapid_fail_protection.0.max_crashes", "10"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "process_model.0.idle_timeout", "45m"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "recycling.0.time_interval", "12h"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "recycling.0.schedule.#", "2"),
+					resource.TestCheckTypeSetElemAttr("iis_application_pool.test", "recycling.0.schedule.*", "02:00"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "process_model.0.ping_interval", "30s"),
+					// Settings which are not configured keep the server defaults
+					resource.TestCheckResourceAttr("iis_application_pool.test", "queue_length", "1000"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "rapid_fail_protection.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "iis_application_pool.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationPoolConfig(status string) string {
+	return fmt.Sprintf(`
+resource "iis_application_pool" "test" {
+  name   = "test-pool"
+  status = %q
+}
+`, status)
+}
+
+func testAccResourceApplicationPoolSettingsConfig() string {
+	return `
+resource "iis_application_pool" "test" {
+  name               = "test-pool"
+  pipeline_mode      = "classic"
+  enable_32bit_win64 = true
+  start_mode         = "AlwaysRunning"
+
+  cpu {
+    limit  = 50000
+    action = "Throttle"
+  }
+
+  process_model {
+    idle_timeout = "PT45M"
+  }
+
+  recycling {
+    time_interval = "12h"
+    schedule      = ["14:30", "02:00"]
+  }
+
+  rapid_fail_protection {
+    max_crashes = 10
+  }
+}
+`
+}
+
+func TestAccResourceApplicationPool_specificUser(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceApplicationPoolIdentityConfig("first", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_application_pool.test", "identity.0.type", "SpecificUser"),
+					resource.TestCheckResourceAttr("iis_application_pool.test", "identity.0.username", `DOMAIN\svc-web`),
+					resource.TestCheckNoResourceAttr("iis_application_pool.test", "identity.0.password"),
+					testAccCheckAppPoolPassword(server, "first"),
+				),
+			},
+			{
+				// A new password is not sent without a version change
+				Config: testAccProviderConfig(server) + testAccResourceApplicationPoolIdentityConfig("second", "1"),
+				Check:  testAccCheckAppPoolPassword(server, "first"),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceApplicationPoolIdentityConfig("second", "2"),
+				Check:  testAccCheckAppPoolPassword(server, "second"),
+			},
+		},
+	})
+}
+
+func testAccCheckAppPoolPassword(server *iistest.Server, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		id := s.RootModule().Resources["iis_application_pool.test"].Primary.ID
+		if password := server.AppPoolPassword(id); password != expected {
+			return fmt.Errorf("expected app pool password %q, got %q", expected, password)
+		}
+		return nil
+	}
+}
+
+func testAccResourceApplicationPoolIdentityConfig(password, version string) string {
+	return fmt.Sprintf(`
+resource "iis_application_pool" "test" {
+  name = "test-pool"
+
+  identity {
+    type             = "SpecificUser"
+    username         = "DOMAIN\\svc-web"
+    password         = %q
+    password_version = %q
+  }
+}
+`, password, version)
+}
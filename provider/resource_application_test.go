@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func TestAccResourceApplication_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: testAccCheckDestroy(server, "iis_application", func(ctx context.Context, client *iis.Client, id string) error {
+			_, err := client.ReadApplication(ctx, id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceApplicationConfig(`C:\inetpub\app`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_application.test", "path", "/app"),
+					resource.TestCheckResourceAttr("iis_application.test", "location", "test-site/app"),
+					resource.TestCheckResourceAttrPair("iis_application.test", "website", "iis_website.test", "id"),
+					resource.TestCheckResourceAttrPair("iis_application.test", "application_pool", "iis_application_pool.test", "id"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceApplicationConfig(`C:\inetpub\app-v2`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_application.test", "physical_path", `C:\inetpub\app-v2`),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceApplicationConfig(physicalPath string) string {
+	return fmt.Sprintf(`
+resource "iis_application_pool" "test" {
+  name = "test-pool"
+}
+
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_application" "test" {
+  path             = "/app"
+  physical_path    = %q
+  website          = iis_website.test.id
+  application_pool = iis_application_pool.test.id
+}
+`, physicalPath)
+}
@@ -106,6 +106,12 @@ func resourceAuthenticationRead(ctx context.Context, d *schema.ResourceData, m i
 	client := m.(*iis.Client)
 	auth, err := client.ReadAuthentication(ctx, d.Id())
 	if err != nil {
+		// If the website or application of the authentication settings was deleted (404), remove from state
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Authentication not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.FromErr(err)
 	}
 	tflog.Debug(ctx, "Read authentication: "+toJSON(auth))
@@ -243,8 +249,7 @@ func readAuthenticationProvider(ctx context.Context, d *schema.ResourceData, key
 	if err != nil {
 		return err
 	}
-	providerList := make([]map[string]interface{}, 1)
-	providerList = append(providerList, provider.ToMap())
+	providerList := []map[string]interface{}{provider.ToMap()}
 	if err := d.Set(key, providerList); err != nil {
 		return err
 	}
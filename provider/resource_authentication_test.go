@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAuthentication_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceAuthenticationConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_authentication.test", "anonymous.0.enabled", "true"),
+					resource.TestCheckResourceAttr("iis_authentication.test", "windows.0.enabled", "false"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceAuthenticationConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_authentication.test", "anonymous.0.enabled", "false"),
+					resource.TestCheckResourceAttr("iis_authentication.test", "windows.0.enabled", "true"),
+					resource.TestCheckResourceAttr("iis_authentication.test", "windows.0.providers.#", "1"),
+					resource.TestCheckResourceAttr("iis_authentication.test", "windows.0.providers.0", "Negotiate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceAuthenticationConfig(anonymous bool) string {
+	providers := `["Negotiate", "NTLM"]`
+	if !anonymous {
+		providers = `["Negotiate"]`
+	}
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_application" "test" {
+  path          = "/app"
+  physical_path = "C:\\inetpub\\app"
+  website       = iis_website.test.id
+}
+
+resource "iis_authentication" "test" {
+  application = iis_application.test.id
+
+  anonymous {
+    enabled = %t
+    user    = "IUSR"
+  }
+
+  basic {
+    enabled = false
+  }
+
+  windows {
+    enabled   = %t
+    providers = %s
+  }
+}
+`, anonymous, !anonymous, providers)
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceAuthorizationRules() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAuthorizationRulesCreate,
+		ReadContext:   resourceAuthorizationRulesRead,
+		UpdateContext: resourceAuthorizationRulesUpdate,
+		DeleteContext: resourceAuthorizationRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"bypass_login_pages": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow all users to access the login pages of forms authentication",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Rules in the order they are evaluated, the inherited rules are kept if not set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+						},
+						"users": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "User names, * for all users and ? for anonymous users",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Roles or Windows groups, e.g. CONTOSO\\Admins",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"verbs": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "HTTP verbs the rule applies to, all verbs if empty",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceAuthorizationRulesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	authorization, err := client.ReadAuthorization(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read authorization: "+toJSON(authorization))
+	d.SetId(authorization.ID)
+	return updateAuthorizationRules(ctx, d, client)
+}
+
+func resourceAuthorizationRulesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	authorization, err := client.ReadAuthorizationByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Authorization not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read authorization: "+toJSON(authorization))
+	if err = setFeatureScope(ctx, d, client, authorization.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+	rules, err := client.ListAuthorizationRules(ctx, authorization.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("bypass_login_pages", authorization.BypassLoginPages); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rule", flattenAuthorizationRules(rules)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceAuthorizationRulesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateAuthorizationRules(ctx, d, m.(*iis.Client))
+}
+
+func updateAuthorizationRules(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if isConfigured(d, "bypass_login_pages") && (d.IsNewResource() || d.HasChange("bypass_login_pages")) {
+		request := iis.UpdateAuthorizationRequest{BypassLoginPages: d.Get("bypass_login_pages").(bool)}
+		tflog.Debug(ctx, "Updating authorization: "+toJSON(request))
+		if _, err := client.UpdateAuthorization(ctx, id, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if isConfigured(d, "rule") && (d.IsNewResource() || d.HasChange("rule")) {
+		rules, err := expandAuthorizationRules(getList(d, "rule"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = reconcileAuthorizationRules(ctx, client, id, rules); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceAuthorizationRulesRead(ctx, d, client)
+}
+
+func resourceAuthorizationRulesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Reverting authorization to inherited rules: "+toJSON(id))
+	if err := client.DeleteAuthorization(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// reconcileAuthorizationRules changes the rules of a scope to the desired order.
+// IIS adds rules at the end, so the longest head of the current rules which is
+// already in the desired order is kept and the remaining rules are (re-)added.
+func reconcileAuthorizationRules(ctx context.Context, client *iis.Client, id string, desired []iis.AuthorizationRule) error {
+	current, err := client.ListAuthorizationRules(ctx, id)
+	if err != nil {
+		return err
+	}
+	i := 0
+	for _, rule := range current {
+		if i < len(desired) && equalAuthorizationRules(rule, desired[i]) {
+			i++
+			continue
+		}
+		tflog.Debug(ctx, "Removing authorization rule: "+toJSON(rule))
+		if err := client.DeleteAuthorizationRule(ctx, rule.ID); err != nil {
+			return err
+		}
+	}
+	for _, rule := range desired[i:] {
+		tflog.Debug(ctx, "Adding authorization rule: "+toJSON(rule))
+		if _, err := client.CreateAuthorizationRule(ctx, id, rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func equalAuthorizationRules(a, b iis.AuthorizationRule) bool {
+	return strings.EqualFold(a.AccessType, b.AccessType) &&
+		strings.EqualFold(joinAuthorizationList(splitAuthorizationList(a.Users)), joinAuthorizationList(splitAuthorizationList(b.Users))) &&
+		strings.EqualFold(joinAuthorizationList(splitAuthorizationList(a.Roles)), joinAuthorizationList(splitAuthorizationList(b.Roles))) &&
+		strings.EqualFold(joinAuthorizationList(splitAuthorizationList(a.Verbs)), joinAuthorizationList(splitAuthorizationList(b.Verbs)))
+}
+
+// splitAuthorizationList splits the comma separated users, roles or verbs of a rule
+func splitAuthorizationList(value string) []string {
+	list := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func joinAuthorizationList(list []string) string {
+	return strings.Join(list, ",")
+}
+
+func expandAuthorizationRules(list []interface{}) ([]iis.AuthorizationRule, error) {
+	rules := make([]iis.AuthorizationRule, 0, len(list))
+	for i, item := range list {
+		rule := item.(map[string]interface{})
+		expanded := iis.AuthorizationRule{
+			AccessType: rule["access_type"].(string),
+			Users:      joinAuthorizationList(toStrings(rule["users"].([]interface{}))),
+			Roles:      joinAuthorizationList(toStrings(rule["roles"].([]interface{}))),
+			Verbs:      joinAuthorizationList(toStrings(rule["verbs"].([]interface{}))),
+		}
+		if expanded.Users == "" && expanded.Roles == "" {
+			return nil, fmt.Errorf("rule %d: at least one of users or roles has to be set", i)
+		}
+		rules = append(rules, expanded)
+	}
+	return rules, nil
+}
+
+func flattenAuthorizationRules(rules []iis.AuthorizationRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"access_type": strings.ToLower(rule.AccessType),
+			"users":       splitAuthorizationList(rule.Users),
+			"roles":       splitAuthorizationList(rule.Roles),
+			"verbs":       splitAuthorizationList(rule.Verbs),
+		})
+	}
+	return flattened
+}
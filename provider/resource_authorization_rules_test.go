@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAuthorizationRules_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceAuthorizationRulesConfig("deny", "allow"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_authorization_rules.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("iis_authorization_rules.test", "rule.0.access_type", "deny"),
+					resource.TestCheckResourceAttr("iis_authorization_rules.test", "rule.0.users.0", "?"),
+					resource.TestCheckResourceAttr("iis_authorization_rules.test", "rule.1.access_type", "allow"),
+					resource.TestCheckResourceAttr("iis_authorization_rules.test", "rule.1.roles.#", "2"),
+				),
+			},
+			{
+				// Reordering the rules
+				Config: testAccProviderConfig(server) + testAccResourceAuthorizationRulesConfig("allow", "deny"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_authorization_rules.test", "rule.#", "2"),
+					resource.TestCheckResourceAttr("iis_authorization_rules.test", "rule.0.access_type", "allow"),
+					resource.TestCheckResourceAttr("iis_authorization_rules.test", "rule.1.access_type", "deny"),
+				),
+			},
+			{
+				ResourceName:      "iis_authorization_rules.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceAuthorizationRulesConfig(first, second string) string {
+	rules := map[string]string{
+		"deny": `
+  rule {
+    access_type = "deny"
+    users       = ["?"]
+  }
+`,
+		"allow": `
+  rule {
+    access_type = "allow"
+    roles       = ["CONTOSO\\WebAdmins", "CONTOSO\\Operators"]
+  }
+`,
+	}
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_authorization_rules" "test" {
+  website = iis_website.test.id
+%s%s}
+`, rules[first], rules[second])
+}
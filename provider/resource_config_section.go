@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceConfigSection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConfigSectionCreate,
+		ReadContext:   resourceConfigSectionRead,
+		UpdateContext: resourceConfigSectionUpdate,
+		DeleteContext: resourceConfigSectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"feature": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(featureNamePattern, "must be the path of a feature below /api, e.g. webserver/http-response-headers"),
+				Description:  "Path of the feature below /api of the IIS Administration API, e.g. webserver/http-response-headers",
+			},
+			"website_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the website the settings are configured for, the server settings are managed if not set",
+			},
+			PathKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"website_name"},
+				ValidateFunc: validation.StringMatch(virtualPathPattern, "must start with '/', e.g. /app"),
+				Description:  "Path of the application within the website the settings are configured for, e.g. /app",
+			},
+			"settings": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateSettingsJSON,
+				DiffSuppressFunc: suppressEquivalentJSON,
+				Description:      "JSON object of the settings to manage, only these keys are changed and compared with the server",
+			},
+		},
+	}
+}
+
+func resourceConfigSectionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	scope := d.Get("website_name").(string) + d.Get(PathKey).(string)
+	section, err := client.ReadConfigSection(ctx, d.Get("feature").(string), scope)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read config section: "+toJSON(section))
+	href, err := section.Href()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(href)
+	return updateConfigSection(ctx, d, client)
+}
+
+func resourceConfigSectionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	section, err := client.ReadConfigSectionByHref(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Config section not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read config section: "+toJSON(section))
+
+	// Only the configured settings are read back, an imported section has none
+	configured := map[string]interface{}{}
+	if settings := d.Get("settings").(string); settings != "" {
+		if err = json.Unmarshal([]byte(settings), &configured); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	websiteName, location, _ := strings.Cut(strings.TrimSuffix(section.Scope(), "/"), "/")
+	values := map[string]interface{}{
+		"feature":      strings.TrimPrefix(path.Dir(d.Id()), "/api/"),
+		"website_name": websiteName,
+		PathKey:        "",
+		"settings":     toJSON(selectSettings(configured, section)),
+	}
+	if location != "" {
+		values[PathKey] = "/" + location
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceConfigSectionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateConfigSection(ctx, d, m.(*iis.Client))
+}
+
+func updateConfigSection(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	href := d.Id()
+	if d.IsNewResource() || d.HasChange("settings") {
+		var settings map[string]interface{}
+		if err := json.Unmarshal([]byte(d.Get("settings").(string)), &settings); err != nil {
+			return diag.FromErr(err)
+		}
+		// Settings which are no longer configured keep their value
+		tflog.Debug(ctx, "Updating config section "+href+": "+toJSON(settings))
+		if _, err := client.UpdateConfigSection(ctx, href, settings); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceConfigSectionRead(ctx, d, client)
+}
+
+func resourceConfigSectionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Reverting the section would reset settings which are not managed by
+	// this resource, so the settings are kept as they are
+	tflog.Debug(ctx, "Removing config section from state: "+toJSON(d.Id()))
+	return nil
+}
+
+// selectSettings returns the values of the configured keys from the section,
+// nested objects only contain their configured keys as well
+func selectSettings(configured map[string]interface{}, section map[string]interface{}) map[string]interface{} {
+	selected := make(map[string]interface{}, len(configured))
+	for key, value := range configured {
+		current, ok := section[key]
+		if !ok {
+			continue
+		}
+		nested, isObject := value.(map[string]interface{})
+		currentNested, isCurrentObject := current.(map[string]interface{})
+		if isObject && isCurrentObject {
+			selected[key] = selectSettings(nested, currentNested)
+		} else {
+			selected[key] = current
+		}
+	}
+	return selected
+}
+
+func validateSettingsJSON(v interface{}, k string) ([]string, []error) {
+	var settings map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &settings); err != nil || settings == nil {
+		return nil, []error{fmt.Errorf("%s must be a JSON object", k)}
+	}
+	return nil, nil
+}
+
+// suppressEquivalentJSON ignores differences in formatting and key order
+func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if json.Unmarshal([]byte(old), &oldValue) != nil || json.Unmarshal([]byte(new), &newValue) != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
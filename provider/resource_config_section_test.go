@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceConfigSection_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceConfigSectionConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_config_section.server", "settings", `{"allow_keep_alive":false}`),
+					resource.TestCheckResourceAttr("iis_config_section.test", "path", "/app"),
+					resource.TestCheckResourceAttr("iis_config_section.test", "settings", `{"allowed_attributes":{"long_date":false},"enabled":true}`),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceConfigSectionConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_config_section.test", "settings", `{"allowed_attributes":{"long_date":true},"enabled":true}`),
+				),
+			},
+			{
+				ResourceName:            "iis_config_section.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings"},
+			},
+		},
+	})
+}
+
+func testAccResourceConfigSectionConfig(longDate bool) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_application" "test" {
+  website       = iis_website.test.id
+  path          = "/app"
+  physical_path = "C:\\inetpub\\app"
+}
+
+resource "iis_config_section" "server" {
+  feature  = "webserver/http-response-headers"
+  settings = jsonencode({ allow_keep_alive = false })
+}
+
+resource "iis_config_section" "test" {
+  feature      = "webserver/directory-browsing"
+  website_name = iis_website.test.name
+  path         = iis_application.test.path
+
+  settings = jsonencode({
+    enabled = true
+    allowed_attributes = {
+      long_date = %t
+    }
+  })
+}
+`, longDate)
+}
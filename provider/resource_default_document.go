@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceDefaultDocument() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDefaultDocumentCreate,
+		ReadContext:   resourceDefaultDocumentRead,
+		UpdateContext: resourceDefaultDocumentUpdate,
+		DeleteContext: resourceDefaultDocumentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"documents": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default documents in the order IIS tries them, the inherited documents are kept if not set",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func resourceDefaultDocumentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	// The default documents of a scope always exist, creating the resource takes over their settings
+	document, err := client.ReadDefaultDocument(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read default document: "+toJSON(document))
+	d.SetId(document.ID)
+	return updateDefaultDocument(ctx, d, client)
+}
+
+func resourceDefaultDocumentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	document, err := client.ReadDefaultDocumentByID(ctx, d.Id())
+	if err != nil {
+		// If the website or application was deleted (404), remove from state
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Default document not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read default document: "+toJSON(document))
+	files, err := client.ListDefaultDocumentFiles(ctx, document.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setFeatureScope(ctx, d, client, document.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enabled", document.Enabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("documents", defaultDocumentNames(files)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceDefaultDocumentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateDefaultDocument(ctx, d, m.(*iis.Client))
+}
+
+func updateDefaultDocument(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChange("enabled") {
+		request := iis.UpdateDefaultDocumentRequest{Enabled: d.Get("enabled").(bool)}
+		tflog.Debug(ctx, "Updating default document: "+toJSON(request))
+		if _, err := client.UpdateDefaultDocument(ctx, id, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if isConfigured(d, "documents") && (d.IsNewResource() || d.HasChange("documents")) {
+		if err := reconcileDefaultDocumentFiles(ctx, client, id, getStringList(d, "documents")); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceDefaultDocumentRead(ctx, d, client)
+}
+
+func resourceDefaultDocumentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Reverting default document to inherited settings: "+toJSON(id))
+	err := client.DeleteDefaultDocument(ctx, id)
+	if err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// reconcileDefaultDocumentFiles changes the documents of a scope to the desired order.
+// IIS adds documents in front of the list, so the longest tail of the current
+// documents which is already in the desired order is kept and the remaining
+// documents are (re-)added from back to front.
+func reconcileDefaultDocumentFiles(ctx context.Context, client *iis.Client, id string, desired []string) error {
+	files, err := client.ListDefaultDocumentFiles(ctx, id)
+	if err != nil {
+		return err
+	}
+	keep := make(map[string]bool)
+	i := len(desired) - 1
+	for j := len(files) - 1; j >= 0 && i >= 0; j-- {
+		if strings.EqualFold(files[j].Name, desired[i]) {
+			keep[files[j].ID] = true
+			i--
+		}
+	}
+	for _, file := range files {
+		if keep[file.ID] {
+			continue
+		}
+		tflog.Debug(ctx, "Removing default document: "+toJSON(file.Name))
+		if err := client.DeleteDefaultDocumentFile(ctx, file.ID); err != nil {
+			return err
+		}
+	}
+	for ; i >= 0; i-- {
+		tflog.Debug(ctx, "Adding default document: "+toJSON(desired[i]))
+		if _, err := client.AddDefaultDocumentFile(ctx, id, desired[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func defaultDocumentNames(files []iis.DefaultDocumentFile) []string {
+	names := make([]string, 0, len(files))
+	for _, file := range files {
+		names = append(names, file.Name)
+	}
+	return names
+}
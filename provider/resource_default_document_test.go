@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDefaultDocument_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceDefaultDocumentConfig("index.html", "default.aspx"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_default_document.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("iis_default_document.test", "documents.#", "2"),
+					resource.TestCheckResourceAttr("iis_default_document.test", "documents.0", "index.html"),
+					resource.TestCheckResourceAttr("iis_default_document.test", "documents.1", "default.aspx"),
+				),
+			},
+			{
+				// Reordering, adding and removing documents
+				Config: testAccProviderConfig(server) + testAccResourceDefaultDocumentConfig("home.htm", "default.aspx", "index.html"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_default_document.test", "documents.#", "3"),
+					resource.TestCheckResourceAttr("iis_default_document.test", "documents.0", "home.htm"),
+					resource.TestCheckResourceAttr("iis_default_document.test", "documents.1", "default.aspx"),
+					resource.TestCheckResourceAttr("iis_default_document.test", "documents.2", "index.html"),
+				),
+			},
+			{
+				ResourceName:      "iis_default_document.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceDefaultDocumentConfig(documents ...string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_default_document" "test" {
+  website   = iis_website.test.id
+  documents = ["%s"]
+}
+`, strings.Join(documents, `", "`))
+}
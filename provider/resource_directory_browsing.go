@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// directoryBrowseAttributes are the columns of a directory listing
+var directoryBrowseAttributes = []string{"date", "time", "size", "extension", "long_date"}
+
+func resourceDirectoryBrowsing() *schema.Resource {
+	attributes := make(map[string]*schema.Schema, len(directoryBrowseAttributes))
+	for _, attribute := range directoryBrowseAttributes {
+		attributes[attribute] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		}
+	}
+	return &schema.Resource{
+		CreateContext: resourceDirectoryBrowsingCreate,
+		ReadContext:   resourceDirectoryBrowsingRead,
+		UpdateContext: resourceDirectoryBrowsingUpdate,
+		DeleteContext: resourceDirectoryBrowsingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "List the content of directories without a default document",
+			},
+			"allowed_attributes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Columns shown in the directory listing",
+				Elem:        &schema.Resource{Schema: attributes},
+			},
+		}),
+	}
+}
+
+func resourceDirectoryBrowsingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	browsing, err := client.ReadDirectoryBrowsing(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read directory browsing: "+toJSON(browsing))
+	d.SetId(browsing.ID)
+	return updateDirectoryBrowsing(ctx, d, client)
+}
+
+func resourceDirectoryBrowsingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	browsing, err := client.ReadDirectoryBrowsingByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Directory browsing not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read directory browsing: "+toJSON(browsing))
+	if err = setServerFeatureScope(ctx, d, client, browsing.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"enabled": browsing.Enabled,
+		"allowed_attributes": []interface{}{map[string]interface{}{
+			"date":      browsing.AllowedAttributes.Date,
+			"time":      browsing.AllowedAttributes.Time,
+			"size":      browsing.AllowedAttributes.Size,
+			"extension": browsing.AllowedAttributes.Extension,
+			"long_date": browsing.AllowedAttributes.LongDate,
+		}},
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceDirectoryBrowsingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateDirectoryBrowsing(ctx, d, m.(*iis.Client))
+}
+
+func updateDirectoryBrowsing(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChanges("enabled", "allowed_attributes") {
+		browsing, err := client.ReadDirectoryBrowsingByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setConfiguredBool(d, "enabled", &browsing.Enabled)
+		setConfiguredBool(d, "allowed_attributes.0.date", &browsing.AllowedAttributes.Date)
+		setConfiguredBool(d, "allowed_attributes.0.time", &browsing.AllowedAttributes.Time)
+		setConfiguredBool(d, "allowed_attributes.0.size", &browsing.AllowedAttributes.Size)
+		setConfiguredBool(d, "allowed_attributes.0.extension", &browsing.AllowedAttributes.Extension)
+		setConfiguredBool(d, "allowed_attributes.0.long_date", &browsing.AllowedAttributes.LongDate)
+		tflog.Debug(ctx, "Updating directory browsing: "+toJSON(browsing))
+		if _, err = client.UpdateDirectoryBrowsing(ctx, *browsing); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceDirectoryBrowsingRead(ctx, d, client)
+}
+
+func resourceDirectoryBrowsingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	if isServerScope(d) {
+		// The server settings cannot be removed, they are kept as they are
+		tflog.Debug(ctx, "Removing server directory browsing from state: "+toJSON(id))
+		return nil
+	}
+	tflog.Debug(ctx, "Reverting directory browsing to inherited settings: "+toJSON(id))
+	if err := client.DeleteDirectoryBrowsing(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
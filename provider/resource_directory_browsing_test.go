@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceDirectoryBrowsing_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceDirectoryBrowsingConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_directory_browsing.server", "enabled", "false"),
+					resource.TestCheckResourceAttr("iis_directory_browsing.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("iis_directory_browsing.test", "allowed_attributes.0.size", "true"),
+					resource.TestCheckResourceAttr("iis_directory_browsing.test", "allowed_attributes.0.long_date", "false"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceDirectoryBrowsingConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_directory_browsing.test", "allowed_attributes.0.long_date", "true"),
+				),
+			},
+			{
+				ResourceName:      "iis_directory_browsing.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceDirectoryBrowsingConfig(longDate bool) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_directory_browsing" "server" {
+  enabled = false
+}
+
+resource "iis_directory_browsing" "test" {
+  website = iis_website.test.id
+  enabled = true
+
+  allowed_attributes {
+    size      = true
+    long_date = %t
+  }
+}
+`, longDate)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func TestAccResourceDirectory_basic(t *testing.T) {
+	server := testAccServer(t)
+	root := server.RootDirectory()
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: testAccCheckDestroy(server, "iis_directory", func(ctx context.Context, client *iis.Client, id string) error {
+			_, err := client.ReadFile(ctx, id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "iis_directory" "test" {
+  name      = "site-404"
+  parent_id = %q
+}
+`, root.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_directory.test", "type", "directory"),
+					resource.TestCheckResourceAttr("iis_directory.test", "exists", "true"),
+					resource.TestCheckResourceAttr("iis_directory.test", "physical_path", root.PhysicalPath+`\site-404`),
+				),
+			},
+		},
+	})
+}
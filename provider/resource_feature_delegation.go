@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+var featureNamePattern = regexp.MustCompile(`^[a-z0-9-]+(/[a-z0-9-]+)*$`)
+
+func resourceFeatureDelegation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFeatureDelegationCreate,
+		ReadContext:   resourceFeatureDelegationRead,
+		UpdateContext: resourceFeatureDelegationUpdate,
+		DeleteContext: resourceFeatureDelegationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			"feature": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(featureNamePattern, "must be the path of a feature below /api/webserver, e.g. ip-restrictions"),
+				Description:  "Path of the feature below /api/webserver of the IIS Administration API, e.g. ip-restrictions or http-request-filtering",
+			},
+			"override_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny", "inherit"}, false),
+				Description:  "Whether the feature can be configured below the scope, inherit uses the mode of the parent scope",
+			},
+			"override_mode_effective": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Override mode in effect at the scope, including inherited modes",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the feature is locked at the scope by the override mode of a parent scope",
+			},
+		}),
+	}
+}
+
+// featureDelegationID joins the feature and its id, the feature is needed to
+// read the override mode of an imported delegation
+func featureDelegationID(feature, id string) string {
+	return feature + "/" + id
+}
+
+func parseFeatureDelegationID(id string) (string, string, error) {
+	i := strings.LastIndex(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		return "", "", fmt.Errorf("invalid id %q, expected <feature>/<id>", id)
+	}
+	return id[:i], id[i+1:], nil
+}
+
+func resourceFeatureDelegationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	name := d.Get("feature").(string)
+	feature, err := client.ReadFeatureDelegation(ctx, name, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read feature delegation: "+toJSON(feature))
+	d.SetId(featureDelegationID(name, feature.ID))
+	return updateFeatureDelegation(ctx, d, client)
+}
+
+func resourceFeatureDelegationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	name, id, err := parseFeatureDelegationID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	feature, err := client.ReadFeatureDelegationByID(ctx, name, id)
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Feature delegation not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read feature delegation: "+toJSON(feature))
+	if err = setServerFeatureScope(ctx, d, client, *feature); err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := feature.Metadata
+	if metadata == nil {
+		metadata = &iis.FeatureMetadata{}
+	}
+	values := map[string]interface{}{
+		"feature":                 name,
+		"override_mode":           metadata.OverrideMode,
+		"override_mode_effective": metadata.OverrideModeEffective,
+		"locked":                  metadata.IsLocked,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceFeatureDelegationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateFeatureDelegation(ctx, d, m.(*iis.Client))
+}
+
+func updateFeatureDelegation(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	if d.IsNewResource() || d.HasChange("override_mode") {
+		name, id, err := parseFeatureDelegationID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		overrideMode := d.Get("override_mode").(string)
+		tflog.Debug(ctx, "Updating override mode of "+name+": "+toJSON(overrideMode))
+		if _, err = client.UpdateFeatureOverrideMode(ctx, name, id, overrideMode); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceFeatureDelegationRead(ctx, d, client)
+}
+
+func resourceFeatureDelegationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	name, id, err := parseFeatureDelegationID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The override mode is reset, the settings of the feature are kept
+	tflog.Debug(ctx, "Resetting override mode of "+name+": "+toJSON(id))
+	if _, err = client.UpdateFeatureOverrideMode(ctx, name, id, "inherit"); err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
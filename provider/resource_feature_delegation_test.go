@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceFeatureDelegation_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceFeatureDelegationConfig("deny", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_feature_delegation.server", "override_mode", "deny"),
+					resource.TestCheckResourceAttr("iis_feature_delegation.server", "override_mode_effective", "deny"),
+					resource.TestCheckResourceAttr("iis_feature_delegation.test", "override_mode_effective", "deny"),
+					resource.TestCheckResourceAttr("iis_feature_delegation.test", "locked", "false"),
+				),
+			},
+			{
+				Config:      testAccProviderConfig(server) + testAccResourceFeatureDelegationConfig("deny", true),
+				ExpectError: regexp.MustCompile(`(?s)locked at a parent scope.*iis_feature_delegation`),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceFeatureDelegationConfig("allow", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_feature_delegation.server", "override_mode_effective", "allow"),
+					resource.TestCheckResourceAttr("iis_directory_browsing.test", "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      "iis_feature_delegation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceFeatureDelegationConfig(overrideMode string, browsing bool) string {
+	config := fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_feature_delegation" "server" {
+  feature       = "directory-browsing"
+  override_mode = %q
+}
+
+resource "iis_feature_delegation" "test" {
+  feature       = "ssl-settings"
+  website       = iis_website.test.id
+  override_mode = "deny"
+}
+`, overrideMode)
+	if browsing {
+		config += `
+resource "iis_directory_browsing" "test" {
+  website    = iis_website.test.id
+  enabled    = true
+  depends_on = [iis_feature_delegation.server]
+}
+`
+	}
+	return config
+}
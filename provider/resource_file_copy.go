@@ -124,9 +124,12 @@ func resourceFileCopyRead(ctx context.Context, d *schema.ResourceData, m interfa
 
 	file, err := client.ReadFile(ctx, d.Id())
 	if err != nil {
-		tflog.Warn(ctx, "File not found during read, marking as deleted: "+err.Error())
-		d.SetId("")
-		return nil
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "File not found during read, marking as deleted: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
 	}
 
 	tflog.Debug(ctx, "Successfully read file: "+file.Name)
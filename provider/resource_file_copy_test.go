@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func TestAccResourceFileCopy_basic(t *testing.T) {
+	server := testAccServer(t)
+	root := server.RootDirectory()
+	source := server.AddFile(root.ID, "web.config", "file")
+	destination := server.AddFile(root.ID, "backup", "directory")
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: testAccCheckDestroy(server, "iis_file_copy", func(ctx context.Context, client *iis.Client, id string) error {
+			_, err := client.ReadFile(ctx, id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + fmt.Sprintf(`
+resource "iis_file_copy" "test" {
+  source_path      = %q
+  destination_path = %q
+  destination_name = "web.config.bak"
+}
+`, source.PhysicalPath, destination.PhysicalPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("iis_file_copy.test", "file_id"),
+				),
+			},
+		},
+	})
+}
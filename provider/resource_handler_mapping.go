@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceHandlerMapping() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHandlerMappingCreate,
+		ReadContext:   resourceHandlerMappingRead,
+		UpdateContext: resourceHandlerMappingUpdate,
+		DeleteContext: resourceHandlerMappingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			NameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Zero-based position of the mapping, the first matching mapping handles a request. New mappings are added in front of the inherited mappings if not set",
+			},
+			PathKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "File name or pattern of the requests, e.g. *.php",
+			},
+			"verbs": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "*",
+				Description: "Comma separated verbs of the requests, e.g. GET,HEAD,POST",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"type", "modules"},
+				Description:  "Managed handler type, e.g. System.Web.Handlers.TransferRequestHandler",
+			},
+			"modules": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"type", "modules"},
+				Description:  "Comma separated native modules handling the requests, e.g. FastCgiModule",
+			},
+			"script_processor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Executable or library processing the requests, e.g. C:\\PHP\\php-cgi.exe",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "unspecified",
+				ValidateFunc: validation.StringInSlice([]string{"file", "directory", "either", "unspecified"}, false),
+				Description:  "Only invoke the handler if the request maps to a file, directory or either",
+			},
+			"require_access": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "script",
+				ValidateFunc: validation.StringInSlice([]string{"none", "read", "write", "script", "execute"}, false),
+			},
+			"allow_path_info": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"precondition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma separated preconditions, e.g. bitness64 or integratedMode",
+			},
+			"response_buffer_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum size of the response buffer in bytes",
+			},
+		}),
+	}
+}
+
+func resourceHandlerMappingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	handlers, err := client.ReadHttpHandlers(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mapping := expandHandlerMapping(d)
+	tflog.Debug(ctx, "Creating handler mapping: "+toJSON(mapping))
+	created, err := client.CreateHandlerMapping(ctx, handlers.ID, mapping)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created handler mapping: "+toJSON(created))
+	d.SetId(created.ID)
+	return resourceHandlerMappingRead(ctx, d, m)
+}
+
+func resourceHandlerMappingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	mapping, err := client.ReadHandlerMapping(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Handler mapping not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read handler mapping: "+toJSON(mapping))
+	err = setEntryFeatureScope(ctx, d, client, mapping.HttpHandler, func(id string) (*iis.Feature, error) {
+		handlers, err := client.ReadHttpHandlersByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &handlers.Feature, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		NameKey:                 mapping.Name,
+		"priority":              flattenPriority(mapping.Priority),
+		PathKey:                 mapping.Path,
+		"verbs":                 mapping.Verbs,
+		"type":                  mapping.Type,
+		"modules":               mapping.Modules,
+		"script_processor":      mapping.ScriptProcessor,
+		"resource_type":         mapping.ResourceType,
+		"require_access":        mapping.RequireAccess,
+		"allow_path_info":       mapping.AllowPathInfo,
+		"precondition":          mapping.Precondition,
+		"response_buffer_limit": mapping.ResponseBufferLimit,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceHandlerMappingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	mapping := expandHandlerMapping(d)
+	tflog.Debug(ctx, "Updating handler mapping: "+toJSON(mapping))
+	updated, err := client.UpdateHandlerMapping(ctx, id, mapping)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated handler mapping: "+toJSON(updated))
+	return resourceHandlerMappingRead(ctx, d, m)
+}
+
+func resourceHandlerMappingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting handler mapping: "+toJSON(id))
+	err := client.DeleteHandlerMapping(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted handler mapping: "+toJSON(id))
+	return nil
+}
+
+func expandHandlerMapping(d *schema.ResourceData) iis.HandlerMapping {
+	mapping := iis.HandlerMapping{
+		Name:            d.Get(NameKey).(string),
+		Priority:        getPriority(d),
+		Path:            d.Get(PathKey).(string),
+		Verbs:           d.Get("verbs").(string),
+		Type:            d.Get("type").(string),
+		Modules:         d.Get("modules").(string),
+		ScriptProcessor: d.Get("script_processor").(string),
+		ResourceType:    d.Get("resource_type").(string),
+		RequireAccess:   d.Get("require_access").(string),
+		AllowPathInfo:   d.Get("allow_path_info").(bool),
+		Precondition:    d.Get("precondition").(string),
+	}
+	setConfiguredInt64(d, "response_buffer_limit", &mapping.ResponseBufferLimit)
+	return mapping
+}
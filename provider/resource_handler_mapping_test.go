@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceHandlerMapping_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceHandlerMappingConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_handler_mapping.php", "priority", "0"),
+					resource.TestCheckResourceAttr("iis_handler_mapping.php", "require_access", "script"),
+				),
+			},
+			{
+				// Moving the mapping behind the inherited ExtensionlessUrlHandler
+				Config: testAccProviderConfig(server) + testAccResourceHandlerMappingConfig("priority = 1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_handler_mapping.php", "priority", "1"),
+					resource.TestCheckResourceAttr("data.iis_handler_mappings.test", "handler_mappings.1.name", "PHP"),
+				),
+			},
+			{
+				ResourceName:      "iis_handler_mapping.php",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceHandlerMappingConfig(priority string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_handler_mapping" "php" {
+  website          = iis_website.test.id
+  name             = "PHP"
+  path             = "*.php"
+  verbs            = "GET,HEAD,POST"
+  modules          = "FastCgiModule"
+  script_processor = "C:\\PHP\\php-cgi.exe"
+  resource_type    = "either"
+  %s
+}
+
+data "iis_handler_mappings" "test" {
+  website = iis_website.test.id
+
+  depends_on = [iis_handler_mapping.php]
+}
+`, priority)
+}
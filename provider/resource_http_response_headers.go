@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const (
+	authoritativeMode = "authoritative"
+	additiveMode      = "additive"
+)
+
+// responseHeaderKinds maps the header blocks to their IIS collections
+var responseHeaderKinds = map[string]iis.ResponseHeaderKind{
+	"custom_header":   iis.CustomHeaders,
+	"redirect_header": iis.RedirectHeaders,
+}
+
+var responseHeaderSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		NameKey: {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	},
+}
+
+func resourceHttpResponseHeaders() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHttpResponseHeadersCreate,
+		ReadContext:   resourceHttpResponseHeadersRead,
+		UpdateContext: resourceHttpResponseHeadersUpdate,
+		DeleteContext: resourceHttpResponseHeadersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      additiveMode,
+				ValidateFunc: validation.StringInSlice([]string{authoritativeMode, additiveMode}, false),
+				Description:  "additive (default) only manages the configured headers, authoritative also removes the inherited headers which are not configured",
+			},
+			"allow_keep_alive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"custom_header": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Headers added to every response",
+				Elem:        responseHeaderSchema,
+			},
+			"redirect_header": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Headers added to redirect responses",
+				Elem:        responseHeaderSchema,
+			},
+			"remove_header": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Names of custom headers which are removed, e.g. the X-Powered-By header inherited from the server",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func resourceHttpResponseHeadersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	headers, err := client.ReadHttpResponseHeaders(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read http response headers: "+toJSON(headers))
+	d.SetId(headers.ID)
+	return updateHttpResponseHeaders(ctx, d, client)
+}
+
+func resourceHttpResponseHeadersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	headers, err := client.ReadHttpResponseHeadersByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Http response headers not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read http response headers: "+toJSON(headers))
+
+	if err = setFeatureScope(ctx, d, client, headers.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+	mode := d.Get("mode").(string)
+	if mode == "" {
+		// Imported resources manage all headers
+		mode = authoritativeMode
+	}
+	values := map[string]interface{}{
+		"mode":             mode,
+		"allow_keep_alive": headers.AllowKeepAlive,
+	}
+	for key, kind := range responseHeaderKinds {
+		list, err := client.ListResponseHeaders(ctx, kind, headers.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// Headers of other tooling are ignored in additive mode
+		entries := newResponseHeaderEntries(ctx, client, kind, headers.ID)
+		values[key] = flattenResponseHeaders(entries.filter(list, expandResponseHeaders(d.Get(key)), mode == authoritativeMode))
+		if kind == iis.CustomHeaders {
+			values["remove_header"] = removedResponseHeaders(d, list)
+		}
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceHttpResponseHeadersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateHttpResponseHeaders(ctx, d, m.(*iis.Client))
+}
+
+func updateHttpResponseHeaders(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	configured := entriesByKey(expandResponseHeaders(d.Get("custom_header")), func(header iis.ResponseHeader) string { return header.Name })
+	for _, name := range toStrings(d.Get("remove_header").(*schema.Set).List()) {
+		if _, ok := configured[strings.ToLower(name)]; ok {
+			return diag.Errorf("%s is configured as custom_header and in remove_header", name)
+		}
+	}
+	if isConfigured(d, "allow_keep_alive") && (d.IsNewResource() || d.HasChange("allow_keep_alive")) {
+		request := iis.UpdateHttpResponseHeadersRequest{AllowKeepAlive: d.Get("allow_keep_alive").(bool)}
+		tflog.Debug(ctx, "Updating http response headers: "+toJSON(request))
+		if _, err := client.UpdateHttpResponseHeaders(ctx, id, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	authoritative := d.Get("mode").(string) == authoritativeMode
+	for key, kind := range responseHeaderKinds {
+		if !d.IsNewResource() && !d.HasChanges(key, "mode", "remove_header") {
+			continue
+		}
+		current, err := client.ListResponseHeaders(ctx, kind, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		old, _ := d.GetChange(key)
+		managed := expandResponseHeaders(old)
+		if kind == iis.CustomHeaders {
+			// Headers to remove are treated as managed headers which are no longer desired
+			for _, name := range toStrings(d.Get("remove_header").(*schema.Set).List()) {
+				managed = append(managed, iis.ResponseHeader{Name: name})
+			}
+		}
+		if err = newResponseHeaderEntries(ctx, client, kind, id).reconcile(current, managed, expandResponseHeaders(d.Get(key)), authoritative); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceHttpResponseHeadersRead(ctx, d, client)
+}
+
+func resourceHttpResponseHeadersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	if d.Get("mode").(string) == authoritativeMode {
+		tflog.Debug(ctx, "Reverting http response headers to inherited settings: "+toJSON(id))
+		if err := client.DeleteHttpResponseHeaders(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+	// Only the managed headers are removed in additive mode
+	for key, kind := range responseHeaderKinds {
+		current, err := client.ListResponseHeaders(ctx, kind, id)
+		if err != nil {
+			if iis.IsNotFoundError(err) {
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+		if err = newResponseHeaderEntries(ctx, client, kind, id).reconcile(current, expandResponseHeaders(d.Get(key)), nil, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func newResponseHeaderEntries(ctx context.Context, client *iis.Client, kind iis.ResponseHeaderKind, id string) featureEntries[iis.ResponseHeader] {
+	return featureEntries[iis.ResponseHeader]{
+		key:   func(header iis.ResponseHeader) string { return header.Name },
+		id:    func(header iis.ResponseHeader) string { return header.ID },
+		equal: func(a, b iis.ResponseHeader) bool { return a.Value == b.Value },
+		create: func(header iis.ResponseHeader) error {
+			tflog.Debug(ctx, "Adding response header: "+toJSON(header))
+			_, err := client.CreateResponseHeader(ctx, kind, id, header.Name, header.Value)
+			return err
+		},
+		update: func(headerID string, header iis.ResponseHeader) error {
+			tflog.Debug(ctx, "Updating response header: "+toJSON(header))
+			_, err := client.UpdateResponseHeader(ctx, kind, headerID, header.Value)
+			return err
+		},
+		remove: func(headerID string) error {
+			tflog.Debug(ctx, "Removing response header: "+toJSON(headerID))
+			return client.DeleteResponseHeader(ctx, kind, headerID)
+		},
+	}
+}
+
+func expandResponseHeaders(value interface{}) []iis.ResponseHeader {
+	items := value.(*schema.Set).List()
+	headers := make([]iis.ResponseHeader, 0, len(items))
+	for _, item := range items {
+		header := item.(map[string]interface{})
+		headers = append(headers, iis.ResponseHeader{Name: header[NameKey].(string), Value: header["value"].(string)})
+	}
+	return headers
+}
+
+// removedResponseHeaders returns the configured headers to remove which are
+// absent, a header which was added again shows up as drift
+func removedResponseHeaders(d *schema.ResourceData, current []iis.ResponseHeader) []string {
+	present := make(map[string]bool, len(current))
+	for _, header := range current {
+		present[strings.ToLower(header.Name)] = true
+	}
+	removed := make([]string, 0)
+	for _, name := range toStrings(d.Get("remove_header").(*schema.Set).List()) {
+		if !present[strings.ToLower(name)] {
+			removed = append(removed, name)
+		}
+	}
+	return removed
+}
+
+func flattenResponseHeaders(headers []iis.ResponseHeader) []interface{} {
+	flattened := make([]interface{}, 0, len(headers))
+	for _, header := range headers {
+		flattened = append(flattened, map[string]interface{}{
+			NameKey: header.Name,
+			"value": header.Value,
+		})
+	}
+	return flattened
+}
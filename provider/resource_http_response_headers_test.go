@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+	"github.com/maxjoehnk/terraform-provider-iis/iis/iistest"
+)
+
+func TestAccResourceHttpResponseHeaders_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The default additive mode keeps the inherited X-Powered-By header
+				Config: testAccProviderConfig(server) + testAccResourceHttpResponseHeadersConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_http_response_headers.test", "mode", "additive"),
+					resource.TestCheckResourceAttr("iis_http_response_headers.test", "custom_header.#", "2"),
+					resource.TestCheckResourceAttr("iis_http_response_headers.test", "redirect_header.#", "1"),
+					resource.TestCheckResourceAttr("iis_http_response_headers.test", "allow_keep_alive", "false"),
+					testAccCheckCustomHeaders(server, "iis_http_response_headers.test", 3),
+					// Other tooling adds a header, which is ignored in additive mode
+					testAccAddCustomHeader(server, "iis_http_response_headers.test", "X-Served-By", "deploy-agent"),
+				),
+			},
+			{
+				// remove_header drops the inherited header and keeps the header of other tooling
+				Config: testAccProviderConfig(server) + testAccResourceHttpResponseHeadersConfig("", "X-Powered-By"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_http_response_headers.test", "custom_header.#", "2"),
+					resource.TestCheckTypeSetElemAttr("iis_http_response_headers.test", "remove_header.*", "X-Powered-By"),
+					testAccCheckCustomHeader(server, "iis_http_response_headers.test", "X-Powered-By", false),
+					testAccCheckCustomHeader(server, "iis_http_response_headers.test", "X-Served-By", true),
+					testAccCheckCustomHeaders(server, "iis_http_response_headers.test", 3),
+				),
+			},
+			{
+				// Authoritative mode removes all headers which are not configured
+				Config: testAccProviderConfig(server) + testAccResourceHttpResponseHeadersConfig("authoritative"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_http_response_headers.test", "custom_header.#", "2"),
+					testAccCheckCustomHeaders(server, "iis_http_response_headers.test", 2),
+				),
+			},
+			{
+				ResourceName:      "iis_http_response_headers.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckCustomHeaders verifies the number of custom headers on the server
+func testAccCheckCustomHeaders(server *iistest.Server, name string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found", name)
+		}
+		headers, err := server.Client().ListResponseHeaders(context.Background(), iis.CustomHeaders, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(headers) != expected {
+			return fmt.Errorf("expected %d custom headers, got %d", expected, len(headers))
+		}
+		return nil
+	}
+}
+
+// testAccCheckCustomHeader verifies whether a custom header exists on the server
+func testAccCheckCustomHeader(server *iistest.Server, name, header string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found", name)
+		}
+		headers, err := server.Client().ListResponseHeaders(context.Background(), iis.CustomHeaders, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		found := false
+		for _, h := range headers {
+			found = found || strings.EqualFold(h.Name, header)
+		}
+		if found != exists {
+			return fmt.Errorf("expected custom header %s to exist: %t", header, exists)
+		}
+		return nil
+	}
+}
+
+// testAccAddCustomHeader adds a custom header outside of Terraform, like other tooling would
+func testAccAddCustomHeader(server *iistest.Server, name, header, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found", name)
+		}
+		_, err := server.Client().CreateResponseHeader(context.Background(), iis.CustomHeaders, rs.Primary.ID, header, value)
+		return err
+	}
+}
+
+func testAccResourceHttpResponseHeadersConfig(mode string, removeHeaders ...string) string {
+	modeAttribute := ""
+	if mode != "" {
+		modeAttribute = fmt.Sprintf("mode             = %q", mode)
+	}
+	removeAttribute := ""
+	if len(removeHeaders) > 0 {
+		removeAttribute = fmt.Sprintf(`remove_header    = ["%s"]`, strings.Join(removeHeaders, `", "`))
+	}
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_http_response_headers" "test" {
+  website          = iis_website.test.id
+  %s
+  %s
+  allow_keep_alive = false
+
+  custom_header {
+    name  = "X-Content-Type-Options"
+    value = "nosniff"
+  }
+
+  custom_header {
+    name  = "Strict-Transport-Security"
+    value = "max-age=31536000; includeSubDomains"
+  }
+
+  redirect_header {
+    name  = "Cache-Control"
+    value = "no-store"
+  }
+}
+`, modeAttribute, removeAttribute)
+}
@@ -0,0 +1,332 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// ipRestrictionsSettings are the settings of the ip restrictions feature itself
+var ipRestrictionsSettings = []string{
+	"allow_unlisted", "deny_action", "enable_reverse_dns", "enable_proxy_mode", "logging_only_mode",
+	"deny_by_concurrent_requests", "deny_by_request_rate",
+}
+
+var ipRestrictionsConcurrentRequestsSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"max_concurrent_requests": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	},
+}
+
+var ipRestrictionsRequestRateSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+		"max_requests": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"time_period": timeSpanSchema("Period in which the requests are counted (e.g. 200ms or 1s)"),
+	},
+}
+
+func resourceIPRestrictions() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIPRestrictionsCreate,
+		ReadContext:   resourceIPRestrictionsRead,
+		UpdateContext: resourceIPRestrictionsUpdate,
+		DeleteContext: resourceIPRestrictionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"allow_unlisted": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow clients which do not match a rule",
+			},
+			"deny_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Abort", "Unauthorized", "Forbidden", "NotFound"}, false),
+				Description:  "Response to denied clients: Abort, Unauthorized, Forbidden, NotFound",
+			},
+			"enable_reverse_dns": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Resolve client addresses for domain name rules",
+			},
+			"enable_proxy_mode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Check the x-forwarded-for header in addition to the client address",
+			},
+			"logging_only_mode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Only log denied requests instead of denying them",
+			},
+			"deny_by_concurrent_requests": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     ipRestrictionsConcurrentRequestsSchema,
+			},
+			"deny_by_request_rate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     ipRestrictionsRequestRateSchema,
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateIPRange,
+							Description:  "Address (10.0.0.1) or CIDR range (10.0.0.0/8)",
+						},
+						"domain_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceIPRestrictionsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	restrictions, err := client.ReadIPRestrictions(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read ip restrictions: "+toJSON(restrictions))
+	d.SetId(restrictions.ID)
+	return updateIPRestrictions(ctx, d, client)
+}
+
+func resourceIPRestrictionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	restrictions, err := client.ReadIPRestrictionsByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Ip restrictions not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read ip restrictions: "+toJSON(restrictions))
+	if err = setFeatureScope(ctx, d, client, restrictions.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+	rules, err := client.ListIPRestrictionRules(ctx, restrictions.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"allow_unlisted":     restrictions.AllowUnlisted,
+		"deny_action":        restrictions.DenyAction,
+		"enable_reverse_dns": restrictions.EnableReverseDNS,
+		"enable_proxy_mode":  restrictions.EnableProxyMode,
+		"logging_only_mode":  restrictions.LoggingOnlyMode,
+		"deny_by_concurrent_requests": []interface{}{map[string]interface{}{
+			"enabled":                 restrictions.DenyByConcurrentRequests.Enabled,
+			"max_concurrent_requests": restrictions.DenyByConcurrentRequests.MaxConcurrentRequests,
+		}},
+		"deny_by_request_rate": []interface{}{map[string]interface{}{
+			"enabled":      restrictions.DenyByRequestRate.Enabled,
+			"max_requests": restrictions.DenyByRequestRate.MaxRequests,
+			"time_period":  iis.FormatTimeSpan(restrictions.DenyByRequestRate.TimePeriod.Duration()),
+		}},
+		"rule": flattenIPRestrictionRules(rules),
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceIPRestrictionsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateIPRestrictions(ctx, d, m.(*iis.Client))
+}
+
+func updateIPRestrictions(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChanges(ipRestrictionsSettings...) {
+		restrictions, err := client.ReadIPRestrictionsByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setConfiguredBool(d, "allow_unlisted", &restrictions.AllowUnlisted)
+		setConfiguredString(d, "deny_action", &restrictions.DenyAction)
+		setConfiguredBool(d, "enable_reverse_dns", &restrictions.EnableReverseDNS)
+		setConfiguredBool(d, "enable_proxy_mode", &restrictions.EnableProxyMode)
+		setConfiguredBool(d, "logging_only_mode", &restrictions.LoggingOnlyMode)
+		setConfiguredBool(d, "deny_by_concurrent_requests.0.enabled", &restrictions.DenyByConcurrentRequests.Enabled)
+		setConfiguredInt64(d, "deny_by_concurrent_requests.0.max_concurrent_requests", &restrictions.DenyByConcurrentRequests.MaxConcurrentRequests)
+		setConfiguredBool(d, "deny_by_request_rate.0.enabled", &restrictions.DenyByRequestRate.Enabled)
+		setConfiguredInt64(d, "deny_by_request_rate.0.max_requests", &restrictions.DenyByRequestRate.MaxRequests)
+		setConfiguredTimeSpan(d, "deny_by_request_rate.0.time_period", (*time.Duration)(&restrictions.DenyByRequestRate.TimePeriod))
+		tflog.Debug(ctx, "Updating ip restrictions: "+toJSON(restrictions))
+		if _, err = client.UpdateIPRestrictions(ctx, *restrictions); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChange("rule") {
+		desired, err := expandIPRestrictionRules(d.Get("rule").(*schema.Set))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		current, err := client.ListIPRestrictionRules(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		entries := featureEntries[iis.IPRestrictionRule]{
+			key:   ipRestrictionRuleKey,
+			id:    func(rule iis.IPRestrictionRule) string { return rule.ID },
+			equal: func(a, b iis.IPRestrictionRule) bool { return a.Allowed == b.Allowed },
+			create: func(rule iis.IPRestrictionRule) error {
+				tflog.Debug(ctx, "Adding ip restriction rule: "+toJSON(rule))
+				_, err := client.CreateIPRestrictionRule(ctx, id, rule)
+				return err
+			},
+			update: func(ruleID string, rule iis.IPRestrictionRule) error {
+				tflog.Debug(ctx, "Updating ip restriction rule: "+toJSON(rule))
+				_, err := client.UpdateIPRestrictionRule(ctx, ruleID, rule)
+				return err
+			},
+			remove: func(ruleID string) error {
+				tflog.Debug(ctx, "Removing ip restriction rule: "+toJSON(ruleID))
+				return client.DeleteIPRestrictionRule(ctx, ruleID)
+			},
+		}
+		if err = entries.reconcile(current, nil, desired, true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIPRestrictionsRead(ctx, d, client)
+}
+
+func resourceIPRestrictionsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Reverting ip restrictions to inherited settings: "+toJSON(id))
+	if err := client.DeleteIPRestrictions(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// validateIPRange accepts addresses and CIDR ranges without host bits, so they are read back unchanged
+func validateIPRange(v interface{}, k string) ([]string, []error) {
+	value := v.(string)
+	if !strings.Contains(value, "/") {
+		if _, err := netip.ParseAddr(value); err != nil {
+			return nil, []error{fmt.Errorf("%q must be an ip address or CIDR range: %w", k, err)}
+		}
+		return nil, nil
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q must be an ip address or CIDR range: %w", k, err)}
+	}
+	if prefix.Bits() == prefix.Addr().BitLen() {
+		return nil, []error{fmt.Errorf("%q: use %s instead of %s for a single address", k, prefix.Addr(), value)}
+	}
+	if masked := prefix.Masked(); masked != prefix {
+		return nil, []error{fmt.Errorf("%q: %s has host bits set, use %s", k, value, masked)}
+	}
+	return nil, nil
+}
+
+// ipRestrictionRuleKey identifies a rule by its range or domain name
+func ipRestrictionRuleKey(rule iis.IPRestrictionRule) string {
+	if rule.IPAddress == "" {
+		return rule.DomainName
+	}
+	return iis.FormatIPRange(rule.IPAddress, rule.SubnetMask)
+}
+
+func expandIPRestrictionRules(set *schema.Set) ([]iis.IPRestrictionRule, error) {
+	rules := make([]iis.IPRestrictionRule, 0, set.Len())
+	for _, item := range set.List() {
+		rule := item.(map[string]interface{})
+		ipAddress, domainName := rule["ip_address"].(string), rule["domain_name"].(string)
+		if (ipAddress == "") == (domainName == "") {
+			return nil, fmt.Errorf("exactly one of ip_address or domain_name has to be set for each rule")
+		}
+		expanded := iis.IPRestrictionRule{Allowed: rule["allowed"].(bool), DomainName: domainName}
+		if ipAddress != "" {
+			address, mask, err := iis.ParseIPRange(ipAddress)
+			if err != nil {
+				return nil, err
+			}
+			expanded.IPAddress, expanded.SubnetMask = address, mask
+		}
+		rules = append(rules, expanded)
+	}
+	return rules, nil
+}
+
+func flattenIPRestrictionRules(rules []iis.IPRestrictionRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		ipAddress := ""
+		if rule.IPAddress != "" {
+			ipAddress = iis.FormatIPRange(rule.IPAddress, rule.SubnetMask)
+		}
+		flattened = append(flattened, map[string]interface{}{
+			"ip_address":  ipAddress,
+			"domain_name": rule.DomainName,
+			"allowed":     rule.Allowed,
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceIPRestrictions_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceIPRestrictionsConfig("192.168.0.0/16"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_ip_restrictions.test", "allow_unlisted", "false"),
+					resource.TestCheckResourceAttr("iis_ip_restrictions.test", "deny_action", "NotFound"),
+					resource.TestCheckResourceAttr("iis_ip_restrictions.test", "deny_by_request_rate.0.enabled", "true"),
+					resource.TestCheckResourceAttr("iis_ip_restrictions.test", "deny_by_request_rate.0.time_period", "1s"),
+					resource.TestCheckResourceAttr("iis_ip_restrictions.test", "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("iis_ip_restrictions.test", "rule.*", map[string]string{
+						"ip_address": "192.168.0.0/16",
+						"allowed":    "true",
+					}),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceIPRestrictionsConfig("172.16.0.0/12"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_ip_restrictions.test", "rule.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("iis_ip_restrictions.test", "rule.*", map[string]string{
+						"ip_address": "172.16.0.0/12",
+						"allowed":    "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      "iis_ip_restrictions.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceIPRestrictionsConfig(officeRange string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_ip_restrictions" "test" {
+  website        = iis_website.test.id
+  allow_unlisted = false
+  deny_action    = "NotFound"
+
+  deny_by_request_rate {
+    enabled     = true
+    time_period = "1s"
+  }
+
+  rule {
+    ip_address = %q
+    allowed    = true
+  }
+
+  rule {
+    ip_address = "10.0.0.1"
+    allowed    = true
+  }
+}
+`, officeRange)
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceModule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceModuleCreate,
+		ReadContext:   resourceModuleRead,
+		UpdateContext: resourceModuleUpdate,
+		DeleteContext: resourceModuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			NameKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the module, native modules have to be installed on the server with this name",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Type of a managed module, e.g. MyApp.RequestLoggingModule, MyApp. Native modules have no type",
+			},
+			"precondition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Comma separated preconditions, e.g. managedHandler to only run for managed requests",
+			},
+		}),
+	}
+}
+
+func resourceModuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	modules, err := client.ReadHttpModules(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	module := expandModule(d)
+	tflog.Debug(ctx, "Creating module: "+toJSON(module))
+	created, err := client.CreateModule(ctx, modules.ID, module)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created module: "+toJSON(created))
+	d.SetId(created.ID)
+	return resourceModuleRead(ctx, d, m)
+}
+
+func resourceModuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	module, err := client.ReadModule(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Module not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read module: "+toJSON(module))
+	err = setEntryFeatureScope(ctx, d, client, module.HttpModule, func(id string) (*iis.Feature, error) {
+		modules, err := client.ReadHttpModulesByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &modules.Feature, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		NameKey:        module.Name,
+		"type":         module.Type,
+		"precondition": module.Precondition,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceModuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	module := expandModule(d)
+	tflog.Debug(ctx, "Updating module: "+toJSON(module))
+	updated, err := client.UpdateModule(ctx, id, module)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated module: "+toJSON(updated))
+	return resourceModuleRead(ctx, d, m)
+}
+
+func resourceModuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting module: "+toJSON(id))
+	err := client.DeleteModule(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted module: "+toJSON(id))
+	return nil
+}
+
+func expandModule(d *schema.ResourceData) iis.Module {
+	return iis.Module{
+		Name:         d.Get(NameKey).(string),
+		Type:         d.Get("type").(string),
+		Precondition: d.Get("precondition").(string),
+	}
+}
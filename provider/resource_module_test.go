@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceModule_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_module" "test" {
+  website      = iis_website.test.id
+  name         = "RequestLogging"
+  type         = "MyApp.RequestLoggingModule, MyApp"
+  precondition = "managedHandler"
+}
+
+data "iis_modules" "test" {
+  website = iis_website.test.id
+
+  depends_on = [iis_module.test]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_module.test", "precondition", "managedHandler"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.iis_modules.test", "modules.*", map[string]string{
+						"name": "RequestLogging",
+						"type": "MyApp.RequestLoggingModule, MyApp",
+					}),
+				),
+			},
+			{
+				ResourceName:      "iis_module.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,591 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+var fileExtensionPattern = regexp.MustCompile(`^\.[^.]`)
+
+// requestFilteringSettings are the settings of the request filtering feature itself
+var requestFilteringSettings = []string{
+	"allow_unlisted_file_extensions", "allow_unlisted_verbs", "allow_high_bit_characters", "allow_double_escaping",
+	"max_allowed_content_length", "max_url_length", "max_query_string_length", "verb",
+}
+
+func resourceRequestFiltering() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRequestFilteringCreate,
+		ReadContext:   resourceRequestFilteringRead,
+		UpdateContext: resourceRequestFilteringUpdate,
+		DeleteContext: resourceRequestFilteringDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      additiveMode,
+				ValidateFunc: validation.StringInSlice([]string{authoritativeMode, additiveMode}, false),
+				Description:  "additive only manages the configured entries, authoritative also removes the inherited entries which are not configured",
+			},
+			"allow_unlisted_file_extensions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_unlisted_verbs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_high_bit_characters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"allow_double_escaping": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"max_allowed_content_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateUint32,
+				Description:  "Maximum length of the request content in bytes",
+			},
+			"max_url_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateUint32,
+			},
+			"max_query_string_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateUint32,
+			},
+			"verb": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"verb": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"file_extension": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"extension": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(fileExtensionPattern, "must start with '.', e.g. .exe"),
+						},
+						"allowed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"hidden_segment": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"segment": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"query_string": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"query_string": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"allow": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+			"rule": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						NameKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"scan_url": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"scan_query_string": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Request headers which are scanned",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"applies_to": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "File extensions the rule applies to, all requests are scanned if empty",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"deny_strings": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceRequestFilteringCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	filtering, err := client.ReadRequestFiltering(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read request filtering: "+toJSON(filtering))
+	d.SetId(filtering.ID)
+	return updateRequestFiltering(ctx, d, client)
+}
+
+func resourceRequestFilteringRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	filtering, err := client.ReadRequestFilteringByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Request filtering not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read request filtering: "+toJSON(filtering))
+	if err = setFeatureScope(ctx, d, client, filtering.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+	mode := d.Get("mode").(string)
+	if mode == "" {
+		// Imported resources manage all entries
+		mode = authoritativeMode
+	}
+	authoritative := mode == authoritativeMode
+	entries := newRequestFilteringEntries(ctx, client, d.Id())
+
+	extensions, err := client.ListFileExtensions(ctx, filtering.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	segments, err := client.ListHiddenSegments(ctx, filtering.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	queryStrings, err := client.ListQueryStrings(ctx, filtering.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rules, err := client.ListRequestFilteringRules(ctx, filtering.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"mode":                           mode,
+		"allow_unlisted_file_extensions": filtering.AllowUnlistedFileExtensions,
+		"allow_unlisted_verbs":           filtering.AllowUnlistedVerbs,
+		"allow_high_bit_characters":      filtering.AllowHighBitCharacters,
+		"allow_double_escaping":          filtering.AllowDoubleEscaping,
+		"max_allowed_content_length":     filtering.MaxContentLength,
+		"max_url_length":                 filtering.MaxUrlLength,
+		"max_query_string_length":        filtering.MaxQueryStringLength,
+		"verb":                           flattenRequestFilteringVerbs(entries.verbs.filter(filtering.Verbs, expandRequestFilteringVerbs(d.Get("verb")), authoritative)),
+		"file_extension":                 flattenFileExtensions(entries.fileExtensions.filter(extensions, expandFileExtensions(d.Get("file_extension")), authoritative)),
+		"hidden_segment":                 flattenHiddenSegments(entries.hiddenSegments.filter(segments, expandHiddenSegments(d.Get("hidden_segment")), authoritative)),
+		"query_string":                   flattenQueryStrings(entries.queryStrings.filter(queryStrings, expandQueryStrings(d.Get("query_string")), authoritative)),
+		"rule":                           flattenRequestFilteringRules(entries.rules.filter(rules, expandRequestFilteringRules(d.Get("rule")), authoritative)),
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceRequestFilteringUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateRequestFiltering(ctx, d, m.(*iis.Client))
+}
+
+func updateRequestFiltering(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	authoritative := d.Get("mode").(string) == authoritativeMode
+	entries := newRequestFilteringEntries(ctx, client, id)
+
+	if d.IsNewResource() || d.HasChanges(append(requestFilteringSettings, "mode")...) {
+		filtering, err := client.ReadRequestFilteringByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setConfiguredBool(d, "allow_unlisted_file_extensions", &filtering.AllowUnlistedFileExtensions)
+		setConfiguredBool(d, "allow_unlisted_verbs", &filtering.AllowUnlistedVerbs)
+		setConfiguredBool(d, "allow_high_bit_characters", &filtering.AllowHighBitCharacters)
+		setConfiguredBool(d, "allow_double_escaping", &filtering.AllowDoubleEscaping)
+		setConfiguredInt64(d, "max_allowed_content_length", &filtering.MaxContentLength)
+		setConfiguredInt64(d, "max_url_length", &filtering.MaxUrlLength)
+		setConfiguredInt64(d, "max_query_string_length", &filtering.MaxQueryStringLength)
+		old, _ := d.GetChange("verb")
+		filtering.Verbs = reconcileRequestFilteringVerbs(filtering.Verbs, expandRequestFilteringVerbs(old), expandRequestFilteringVerbs(d.Get("verb")), authoritative)
+		tflog.Debug(ctx, "Updating request filtering: "+toJSON(filtering))
+		if _, err = client.UpdateRequestFiltering(ctx, *filtering); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChanges("file_extension", "mode") {
+		current, err := client.ListFileExtensions(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		old, _ := d.GetChange("file_extension")
+		if err = entries.fileExtensions.reconcile(current, expandFileExtensions(old), expandFileExtensions(d.Get("file_extension")), authoritative); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.IsNewResource() || d.HasChanges("hidden_segment", "mode") {
+		current, err := client.ListHiddenSegments(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		old, _ := d.GetChange("hidden_segment")
+		if err = entries.hiddenSegments.reconcile(current, expandHiddenSegments(old), expandHiddenSegments(d.Get("hidden_segment")), authoritative); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.IsNewResource() || d.HasChanges("query_string", "mode") {
+		current, err := client.ListQueryStrings(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		old, _ := d.GetChange("query_string")
+		if err = entries.queryStrings.reconcile(current, expandQueryStrings(old), expandQueryStrings(d.Get("query_string")), authoritative); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.IsNewResource() || d.HasChanges("rule", "mode") {
+		current, err := client.ListRequestFilteringRules(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		old, _ := d.GetChange("rule")
+		if err = entries.rules.reconcile(current, expandRequestFilteringRules(old), expandRequestFilteringRules(d.Get("rule")), authoritative); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceRequestFilteringRead(ctx, d, client)
+}
+
+func resourceRequestFilteringDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	if d.Get("mode").(string) == authoritativeMode {
+		tflog.Debug(ctx, "Reverting request filtering to inherited settings: "+toJSON(id))
+		if err := client.DeleteRequestFiltering(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	// Only the managed entries are removed in additive mode, the settings are kept
+	tflog.Debug(ctx, "Removing managed request filtering entries: "+toJSON(id))
+	entries := newRequestFilteringEntries(ctx, client, id)
+	filtering, err := client.ReadRequestFilteringByID(ctx, id)
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if verbs := expandRequestFilteringVerbs(d.Get("verb")); len(verbs) > 0 {
+		filtering.Verbs = reconcileRequestFilteringVerbs(filtering.Verbs, verbs, nil, false)
+		if _, err = client.UpdateRequestFiltering(ctx, *filtering); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	extensions, err := client.ListFileExtensions(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = entries.fileExtensions.reconcile(extensions, expandFileExtensions(d.Get("file_extension")), nil, false); err != nil {
+		return diag.FromErr(err)
+	}
+	segments, err := client.ListHiddenSegments(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = entries.hiddenSegments.reconcile(segments, expandHiddenSegments(d.Get("hidden_segment")), nil, false); err != nil {
+		return diag.FromErr(err)
+	}
+	queryStrings, err := client.ListQueryStrings(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = entries.queryStrings.reconcile(queryStrings, expandQueryStrings(d.Get("query_string")), nil, false); err != nil {
+		return diag.FromErr(err)
+	}
+	rules, err := client.ListRequestFilteringRules(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = entries.rules.reconcile(rules, expandRequestFilteringRules(d.Get("rule")), nil, false); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+type requestFilteringEntries struct {
+	verbs          featureEntries[iis.RequestFilteringVerb]
+	fileExtensions featureEntries[iis.FileExtension]
+	hiddenSegments featureEntries[iis.HiddenSegment]
+	queryStrings   featureEntries[iis.QueryString]
+	rules          featureEntries[iis.RequestFilteringRule]
+}
+
+func newRequestFilteringEntries(ctx context.Context, client *iis.Client, id string) requestFilteringEntries {
+	return requestFilteringEntries{
+		verbs: featureEntries[iis.RequestFilteringVerb]{
+			key: func(verb iis.RequestFilteringVerb) string { return verb.Name },
+		},
+		fileExtensions: featureEntries[iis.FileExtension]{
+			key:   func(extension iis.FileExtension) string { return extension.Extension },
+			id:    func(extension iis.FileExtension) string { return extension.ID },
+			equal: func(a, b iis.FileExtension) bool { return a.Allowed == b.Allowed },
+			create: func(extension iis.FileExtension) error {
+				tflog.Debug(ctx, "Adding file extension: "+toJSON(extension))
+				_, err := client.CreateFileExtension(ctx, id, extension)
+				return err
+			},
+			update: func(entryID string, extension iis.FileExtension) error {
+				tflog.Debug(ctx, "Updating file extension: "+toJSON(extension))
+				_, err := client.UpdateFileExtension(ctx, entryID, extension)
+				return err
+			},
+			remove: func(entryID string) error {
+				tflog.Debug(ctx, "Removing file extension: "+toJSON(entryID))
+				return client.DeleteFileExtension(ctx, entryID)
+			},
+		},
+		hiddenSegments: featureEntries[iis.HiddenSegment]{
+			key:   func(segment iis.HiddenSegment) string { return segment.Segment },
+			id:    func(segment iis.HiddenSegment) string { return segment.ID },
+			equal: func(a, b iis.HiddenSegment) bool { return true },
+			create: func(segment iis.HiddenSegment) error {
+				tflog.Debug(ctx, "Adding hidden segment: "+toJSON(segment))
+				_, err := client.CreateHiddenSegment(ctx, id, segment)
+				return err
+			},
+			remove: func(entryID string) error {
+				tflog.Debug(ctx, "Removing hidden segment: "+toJSON(entryID))
+				return client.DeleteHiddenSegment(ctx, entryID)
+			},
+		},
+		queryStrings: featureEntries[iis.QueryString]{
+			key:   func(queryString iis.QueryString) string { return queryString.QueryString },
+			id:    func(queryString iis.QueryString) string { return queryString.ID },
+			equal: func(a, b iis.QueryString) bool { return a.Allow == b.Allow },
+			create: func(queryString iis.QueryString) error {
+				tflog.Debug(ctx, "Adding query string: "+toJSON(queryString))
+				_, err := client.CreateQueryString(ctx, id, queryString)
+				return err
+			},
+			update: func(entryID string, queryString iis.QueryString) error {
+				tflog.Debug(ctx, "Updating query string: "+toJSON(queryString))
+				_, err := client.UpdateQueryString(ctx, entryID, queryString)
+				return err
+			},
+			remove: func(entryID string) error {
+				tflog.Debug(ctx, "Removing query string: "+toJSON(entryID))
+				return client.DeleteQueryString(ctx, entryID)
+			},
+		},
+		rules: featureEntries[iis.RequestFilteringRule]{
+			key: func(rule iis.RequestFilteringRule) string { return rule.Name },
+			id:  func(rule iis.RequestFilteringRule) string { return rule.ID },
+			equal: func(a, b iis.RequestFilteringRule) bool {
+				return a.ScanUrl == b.ScanUrl && a.ScanQueryString == b.ScanQueryString &&
+					slices.Equal(a.Headers, b.Headers) && slices.Equal(a.AppliesTo, b.AppliesTo) && slices.Equal(a.DenyStrings, b.DenyStrings)
+			},
+			create: func(rule iis.RequestFilteringRule) error {
+				tflog.Debug(ctx, "Adding request filtering rule: "+toJSON(rule))
+				_, err := client.CreateRequestFilteringRule(ctx, id, rule)
+				return err
+			},
+			update: func(entryID string, rule iis.RequestFilteringRule) error {
+				tflog.Debug(ctx, "Updating request filtering rule: "+toJSON(rule))
+				_, err := client.UpdateRequestFilteringRule(ctx, entryID, rule)
+				return err
+			},
+			remove: func(entryID string) error {
+				tflog.Debug(ctx, "Removing request filtering rule: "+toJSON(entryID))
+				return client.DeleteRequestFilteringRule(ctx, entryID)
+			},
+		},
+	}
+}
+
+// reconcileRequestFilteringVerbs returns the verbs of the request filtering
+// after adding, changing and removing the managed verbs
+func reconcileRequestFilteringVerbs(current, managed, desired []iis.RequestFilteringVerb, authoritative bool) []iis.RequestFilteringVerb {
+	wasManaged := entriesByKey(managed, func(verb iis.RequestFilteringVerb) string { return verb.Name })
+	wanted := entriesByKey(desired, func(verb iis.RequestFilteringVerb) string { return verb.Name })
+	verbs := make([]iis.RequestFilteringVerb, 0, len(current)+len(desired))
+	for _, verb := range current {
+		key := strings.ToLower(verb.Name)
+		_, managed := wasManaged[key]
+		if _, ok := wanted[key]; ok || authoritative || managed {
+			continue
+		}
+		verbs = append(verbs, verb)
+	}
+	return append(verbs, desired...)
+}
+
+func expandRequestFilteringVerbs(value interface{}) []iis.RequestFilteringVerb {
+	verbs := make([]iis.RequestFilteringVerb, 0)
+	for _, item := range value.(*schema.Set).List() {
+		verb := item.(map[string]interface{})
+		verbs = append(verbs, iis.RequestFilteringVerb{Name: verb["verb"].(string), Allowed: verb["allowed"].(bool)})
+	}
+	return verbs
+}
+
+func flattenRequestFilteringVerbs(verbs []iis.RequestFilteringVerb) []interface{} {
+	flattened := make([]interface{}, 0, len(verbs))
+	for _, verb := range verbs {
+		flattened = append(flattened, map[string]interface{}{"verb": verb.Name, "allowed": verb.Allowed})
+	}
+	return flattened
+}
+
+func expandFileExtensions(value interface{}) []iis.FileExtension {
+	extensions := make([]iis.FileExtension, 0)
+	for _, item := range value.(*schema.Set).List() {
+		extension := item.(map[string]interface{})
+		extensions = append(extensions, iis.FileExtension{Extension: extension["extension"].(string), Allowed: extension["allowed"].(bool)})
+	}
+	return extensions
+}
+
+func flattenFileExtensions(extensions []iis.FileExtension) []interface{} {
+	flattened := make([]interface{}, 0, len(extensions))
+	for _, extension := range extensions {
+		flattened = append(flattened, map[string]interface{}{"extension": extension.Extension, "allowed": extension.Allowed})
+	}
+	return flattened
+}
+
+func expandHiddenSegments(value interface{}) []iis.HiddenSegment {
+	segments := make([]iis.HiddenSegment, 0)
+	for _, item := range value.(*schema.Set).List() {
+		segments = append(segments, iis.HiddenSegment{Segment: item.(map[string]interface{})["segment"].(string)})
+	}
+	return segments
+}
+
+func flattenHiddenSegments(segments []iis.HiddenSegment) []interface{} {
+	flattened := make([]interface{}, 0, len(segments))
+	for _, segment := range segments {
+		flattened = append(flattened, map[string]interface{}{"segment": segment.Segment})
+	}
+	return flattened
+}
+
+func expandQueryStrings(value interface{}) []iis.QueryString {
+	queryStrings := make([]iis.QueryString, 0)
+	for _, item := range value.(*schema.Set).List() {
+		queryString := item.(map[string]interface{})
+		queryStrings = append(queryStrings, iis.QueryString{QueryString: queryString["query_string"].(string), Allow: queryString["allow"].(bool)})
+	}
+	return queryStrings
+}
+
+func flattenQueryStrings(queryStrings []iis.QueryString) []interface{} {
+	flattened := make([]interface{}, 0, len(queryStrings))
+	for _, queryString := range queryStrings {
+		flattened = append(flattened, map[string]interface{}{"query_string": queryString.QueryString, "allow": queryString.Allow})
+	}
+	return flattened
+}
+
+func expandRequestFilteringRules(value interface{}) []iis.RequestFilteringRule {
+	rules := make([]iis.RequestFilteringRule, 0)
+	for _, item := range value.(*schema.Set).List() {
+		rule := item.(map[string]interface{})
+		rules = append(rules, iis.RequestFilteringRule{
+			Name:            rule[NameKey].(string),
+			ScanUrl:         rule["scan_url"].(bool),
+			ScanQueryString: rule["scan_query_string"].(bool),
+			Headers:         toStrings(rule["headers"].([]interface{})),
+			AppliesTo:       toStrings(rule["applies_to"].([]interface{})),
+			DenyStrings:     toStrings(rule["deny_strings"].([]interface{})),
+		})
+	}
+	return rules
+}
+
+func flattenRequestFilteringRules(rules []iis.RequestFilteringRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			NameKey:             rule.Name,
+			"scan_url":          rule.ScanUrl,
+			"scan_query_string": rule.ScanQueryString,
+			"headers":           rule.Headers,
+			"applies_to":        rule.AppliesTo,
+			"deny_strings":      rule.DenyStrings,
+		})
+	}
+	return flattened
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRequestFiltering_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceRequestFilteringConfig(1048576, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_request_filtering.test", "max_allowed_content_length", "1048576"),
+					resource.TestCheckResourceAttr("iis_request_filtering.test", "max_url_length", "4096"),
+					resource.TestCheckResourceAttr("iis_request_filtering.test", "file_extension.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("iis_request_filtering.test", "file_extension.*", map[string]string{
+						"extension": ".exe",
+						"allowed":   "false",
+					}),
+					resource.TestCheckResourceAttr("iis_request_filtering.test", "hidden_segment.#", "1"),
+					resource.TestCheckResourceAttr("iis_request_filtering.test", "verb.#", "1"),
+					resource.TestCheckResourceAttr("iis_request_filtering.test", "rule.#", "1"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceRequestFilteringConfig(2097152, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_request_filtering.test", "max_allowed_content_length", "2097152"),
+					resource.TestCheckTypeSetElemNestedAttrs("iis_request_filtering.test", "file_extension.*", map[string]string{
+						"extension": ".exe",
+						"allowed":   "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceRequestFilteringConfig(maxContentLength int, allowExe bool) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_request_filtering" "test" {
+  website                    = iis_website.test.id
+  max_allowed_content_length = %d
+
+  verb {
+    verb    = "TRACE"
+    allowed = false
+  }
+
+  file_extension {
+    extension = ".exe"
+    allowed   = %t
+  }
+
+  hidden_segment {
+    segment = ".git"
+  }
+
+  rule {
+    name         = "block-sql-injection"
+    scan_url     = true
+    deny_strings = ["drop table", "--"]
+  }
+}
+`, maxContentLength, allowExe)
+}
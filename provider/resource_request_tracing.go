@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceRequestTracing() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRequestTracingCreate,
+		ReadContext:   resourceRequestTracingRead,
+		UpdateContext: resourceRequestTracingUpdate,
+		DeleteContext: resourceRequestTracingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Trace the requests matching the rules of the scope",
+			},
+			"directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Directory the trace files are written to",
+			},
+			"maximum_number_trace_files": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 10000),
+				Description:  "Number of trace files which are kept, the oldest files are removed",
+			},
+		}),
+	}
+}
+
+func resourceRequestTracingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	// The request tracing of a scope always exists, creating the resource takes over its settings
+	tracing, err := client.ReadRequestTracing(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read request tracing: "+toJSON(tracing))
+	d.SetId(tracing.ID)
+	return updateRequestTracing(ctx, d, client)
+}
+
+func resourceRequestTracingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	tracing, err := client.ReadRequestTracingByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Request tracing not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read request tracing: "+toJSON(tracing))
+	if err = setServerFeatureScope(ctx, d, client, tracing.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"enabled":                    tracing.Enabled,
+		"directory":                  tracing.Directory,
+		"maximum_number_trace_files": tracing.MaximumNumberTraceFiles,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceRequestTracingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateRequestTracing(ctx, d, m.(*iis.Client))
+}
+
+func updateRequestTracing(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChanges("enabled", "directory", "maximum_number_trace_files") {
+		tracing, err := client.ReadRequestTracingByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setConfiguredBool(d, "enabled", &tracing.Enabled)
+		setConfiguredString(d, "directory", &tracing.Directory)
+		setConfiguredInt64(d, "maximum_number_trace_files", &tracing.MaximumNumberTraceFiles)
+		tflog.Debug(ctx, "Updating request tracing: "+toJSON(tracing))
+		if _, err = client.UpdateRequestTracing(ctx, *tracing); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceRequestTracingRead(ctx, d, client)
+}
+
+func resourceRequestTracingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	if isServerScope(d) {
+		// The server settings cannot be removed, they are kept as they are
+		tflog.Debug(ctx, "Removing server request tracing from state: "+toJSON(id))
+		return nil
+	}
+	tflog.Debug(ctx, "Reverting request tracing to inherited settings: "+toJSON(id))
+	if err := client.DeleteRequestTracing(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// statusCodePattern matches status codes with optional sub status, e.g. 404.2, and ranges like 500-599
+var statusCodePattern = regexp.MustCompile(`^\d{3}(\.\d+)?(-\d{3}(\.\d+)?)?$`)
+
+func resourceRequestTracingRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRequestTracingRuleCreate,
+		ReadContext:   resourceRequestTracingRuleRead,
+		UpdateContext: resourceRequestTracingRuleUpdate,
+		DeleteContext: resourceRequestTracingRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			PathKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Requested content which is traced, e.g. * or *.aspx",
+			},
+			"status_codes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Status codes which are traced, e.g. 404.2 or 500-599",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(statusCodePattern, "must be a status code (404, 404.2) or range (500-599)"),
+				},
+			},
+			"time_taken": timeSpanSchema("Trace requests taking longer (e.g. 30s), 0s disables the condition"),
+			"event_severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ignore",
+				ValidateFunc: validation.StringInSlice([]string{"ignore", "critical_error", "error", "warning"}, false),
+				Description:  "Trace requests which log an event of the severity",
+			},
+			"trace": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"WWW Server", "ASP", "ASPNET", "ISAPI Extension"}, false),
+						},
+						"verbosity": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "verbose",
+							ValidateFunc: validation.StringInSlice([]string{"general", "critical_error", "error", "warning", "information", "verbose"}, false),
+						},
+						"areas": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Areas of the provider which are traced, e.g. Authentication, Security, StaticFile or Rewrite",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceRequestTracingRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	tracing, err := client.ReadRequestTracing(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule := expandRequestTracingRule(d)
+	tflog.Debug(ctx, "Creating request tracing rule: "+toJSON(rule))
+	created, err := client.CreateRequestTracingRule(ctx, tracing.ID, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created request tracing rule: "+toJSON(created))
+	d.SetId(created.ID)
+	return resourceRequestTracingRuleRead(ctx, d, m)
+}
+
+func resourceRequestTracingRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	rule, err := client.ReadRequestTracingRule(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Request tracing rule not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read request tracing rule: "+toJSON(rule))
+	err = setEntryFeatureScope(ctx, d, client, rule.RequestTracing, func(id string) (*iis.Feature, error) {
+		tracing, err := client.ReadRequestTracingByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return &tracing.Feature, nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	traces := make([]interface{}, 0, len(rule.Traces))
+	for _, trace := range rule.Traces {
+		areas := make([]string, 0, len(trace.AllowedAreas))
+		for area, allowed := range trace.AllowedAreas {
+			if allowed {
+				areas = append(areas, area)
+			}
+		}
+		traces = append(traces, map[string]interface{}{
+			"provider":  trace.Provider.Name,
+			"verbosity": trace.Verbosity,
+			"areas":     areas,
+		})
+	}
+	values := map[string]interface{}{
+		PathKey:          rule.Path,
+		"status_codes":   rule.StatusCodes,
+		"time_taken":     iis.FormatTimeSpan(rule.MinRequestExecutionTime.Duration()),
+		"event_severity": rule.EventSeverity,
+		"trace":          traces,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceRequestTracingRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	rule := expandRequestTracingRule(d)
+	tflog.Debug(ctx, "Updating request tracing rule: "+toJSON(rule))
+	updated, err := client.UpdateRequestTracingRule(ctx, id, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated request tracing rule: "+toJSON(updated))
+	return resourceRequestTracingRuleRead(ctx, d, m)
+}
+
+func resourceRequestTracingRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting request tracing rule: "+toJSON(id))
+	err := client.DeleteRequestTracingRule(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted request tracing rule: "+toJSON(id))
+	return nil
+}
+
+func expandRequestTracingRule(d *schema.ResourceData) iis.RequestTracingRule {
+	traces := make([]iis.RequestTracingTrace, 0)
+	for _, item := range getList(d, "trace") {
+		trace := item.(map[string]interface{})
+		areas := make(map[string]bool)
+		for _, area := range trace["areas"].(*schema.Set).List() {
+			areas[area.(string)] = true
+		}
+		traces = append(traces, iis.RequestTracingTrace{
+			Provider:     iis.RequestTracingProvider{Name: trace["provider"].(string)},
+			Verbosity:    trace["verbosity"].(string),
+			AllowedAreas: areas,
+		})
+	}
+	rule := iis.RequestTracingRule{
+		Path:          d.Get(PathKey).(string),
+		StatusCodes:   getStringList(d, "status_codes"),
+		EventSeverity: d.Get("event_severity").(string),
+		Traces:        traces,
+	}
+	setConfiguredTimeSpan(d, "time_taken", (*time.Duration)(&rule.MinRequestExecutionTime))
+	return rule
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRequestTracingRule_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceRequestTracingRuleConfig("500-599"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_request_tracing_rule.test", "status_codes.0", "500-599"),
+					resource.TestCheckResourceAttr("iis_request_tracing_rule.test", "time_taken", "30s"),
+					resource.TestCheckResourceAttr("iis_request_tracing_rule.test", "event_severity", "ignore"),
+					resource.TestCheckResourceAttr("iis_request_tracing_rule.test", "trace.#", "2"),
+					resource.TestCheckResourceAttr("iis_request_tracing_rule.test", "trace.0.verbosity", "verbose"),
+					resource.TestCheckTypeSetElemAttr("iis_request_tracing_rule.test", "trace.0.areas.*", "Rewrite"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceRequestTracingRuleConfig("404.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_request_tracing_rule.test", "status_codes.0", "404.2"),
+				),
+			},
+			{
+				ResourceName:      "iis_request_tracing_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceRequestTracingRuleConfig(statusCodes string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_request_tracing_rule" "test" {
+  website      = iis_website.test.id
+  path         = "*"
+  status_codes = [%q]
+  time_taken   = "30s"
+
+  trace {
+    provider = "WWW Server"
+    areas    = ["Security", "Rewrite"]
+  }
+
+  trace {
+    provider  = "ASPNET"
+    verbosity = "warning"
+  }
+}
+`, statusCodes)
+}
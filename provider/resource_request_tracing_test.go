@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceRequestTracing_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceRequestTracingConfig(50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_request_tracing.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("iis_request_tracing.test", "directory", "D:\\logs\\FailedReqLogFiles"),
+					resource.TestCheckResourceAttr("iis_request_tracing.test", "maximum_number_trace_files", "50"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceRequestTracingConfig(200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_request_tracing.test", "maximum_number_trace_files", "200"),
+				),
+			},
+			{
+				ResourceName:      "iis_request_tracing.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceRequestTracingConfig(maxFiles int) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_request_tracing" "test" {
+  website                    = iis_website.test.id
+  enabled                    = true
+  directory                  = "D:\\logs\\FailedReqLogFiles"
+  maximum_number_trace_files = %d
+}
+`, maxFiles)
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+var compressionLevel = validation.IntBetween(0, 10)
+
+func resourceResponseCompression() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceResponseCompressionCreate,
+		ReadContext:   resourceResponseCompressionRead,
+		UpdateContext: resourceResponseCompressionUpdate,
+		DeleteContext: resourceResponseCompressionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			"do_static_compression": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Compress static files, requires the static compression module",
+			},
+			"do_dynamic_compression": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Compress dynamic responses, requires the dynamic compression module",
+			},
+			"server": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{WebsiteKey, ApplicationKey},
+				Description:   "Settings which can only be configured at server level",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Directory compressed static files are cached in",
+						},
+						"do_disk_space_limiting": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"max_disk_space_usage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Disk space limit of the compressed files per application pool in MB",
+						},
+						"min_file_size_to_compress": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Minimum size of a static file to be compressed in bytes",
+						},
+						"scheme": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									NameKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the scheme, which is matched against the Accept-Encoding header, e.g. gzip or br",
+									},
+									"dll": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Computed:    true,
+										Description: "Compression library of the scheme, required for new schemes",
+									},
+									"dynamic_compression_level": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: compressionLevel,
+									},
+									"static_compression_level": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: compressionLevel,
+									},
+								},
+							},
+						},
+						"dynamic_types": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Computed:    true,
+							Description: "Mime types of dynamic responses mapped to whether they are compressed, e.g. {\"application/json\" = true}",
+							Elem: &schema.Schema{
+								Type: schema.TypeBool,
+							},
+						},
+						"static_types": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Computed:    true,
+							Description: "Mime types of static files mapped to whether they are compressed",
+							Elem: &schema.Schema{
+								Type: schema.TypeBool,
+							},
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceResponseCompressionCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	// The compression settings of a scope always exist, creating the resource takes over their settings
+	compression, err := client.ReadResponseCompression(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read response compression: "+toJSON(compression))
+	d.SetId(compression.ID)
+	return updateResponseCompression(ctx, d, client)
+}
+
+func resourceResponseCompressionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	compression, err := client.ReadResponseCompressionByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Response compression not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read response compression: "+toJSON(compression))
+	if err = setServerFeatureScope(ctx, d, client, compression.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"do_static_compression":  compression.DoStaticCompression,
+		"do_dynamic_compression": compression.DoDynamicCompression,
+		"server":                 []interface{}{},
+	}
+	// The server settings are inherited by all websites, they are only read back at server level
+	if isServerScope(d) {
+		schemes := make([]interface{}, 0, len(compression.Schemes))
+		for _, scheme := range compression.Schemes {
+			schemes = append(schemes, map[string]interface{}{
+				NameKey:                     scheme.Name,
+				"dll":                       scheme.Dll,
+				"dynamic_compression_level": scheme.DynamicCompressionLevel,
+				"static_compression_level":  scheme.StaticCompressionLevel,
+			})
+		}
+		values["server"] = []interface{}{map[string]interface{}{
+			"directory":                 compression.Directory,
+			"do_disk_space_limiting":    compression.DoDiskSpaceLimiting,
+			"max_disk_space_usage":      compression.MaxDiskSpaceUsage,
+			"min_file_size_to_compress": compression.MinFileSizeToCompress,
+			"scheme":                    schemes,
+			"dynamic_types":             flattenCompressionMimeTypes(compression.DynamicTypes),
+			"static_types":              flattenCompressionMimeTypes(compression.StaticTypes),
+		}}
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceResponseCompressionUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateResponseCompression(ctx, d, m.(*iis.Client))
+}
+
+func updateResponseCompression(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChanges("do_static_compression", "do_dynamic_compression", "server") {
+		compression, err := client.ReadResponseCompressionByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setConfiguredBool(d, "do_static_compression", &compression.DoStaticCompression)
+		setConfiguredBool(d, "do_dynamic_compression", &compression.DoDynamicCompression)
+		request := iis.UpdateResponseCompressionRequest{
+			DoStaticCompression:  &compression.DoStaticCompression,
+			DoDynamicCompression: &compression.DoDynamicCompression,
+		}
+		if isConfigured(d, "server.0") {
+			setConfiguredString(d, "server.0.directory", &compression.Directory)
+			setConfiguredBool(d, "server.0.do_disk_space_limiting", &compression.DoDiskSpaceLimiting)
+			setConfiguredInt64(d, "server.0.max_disk_space_usage", &compression.MaxDiskSpaceUsage)
+			setConfiguredInt64(d, "server.0.min_file_size_to_compress", &compression.MinFileSizeToCompress)
+			request.Directory = &compression.Directory
+			request.DoDiskSpaceLimiting = &compression.DoDiskSpaceLimiting
+			request.MaxDiskSpaceUsage = &compression.MaxDiskSpaceUsage
+			request.MinFileSizeToCompress = &compression.MinFileSizeToCompress
+			if isConfigured(d, "server.0.scheme") {
+				schemes, err := expandCompressionSchemes(d, compression.Schemes)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+				request.Schemes = schemes
+			}
+			if isConfigured(d, "server.0.dynamic_types") {
+				request.DynamicTypes = expandCompressionMimeTypes(d.Get("server.0.dynamic_types").(map[string]interface{}))
+			}
+			if isConfigured(d, "server.0.static_types") {
+				request.StaticTypes = expandCompressionMimeTypes(d.Get("server.0.static_types").(map[string]interface{}))
+			}
+		}
+		tflog.Debug(ctx, "Updating response compression: "+toJSON(request))
+		if _, err = client.UpdateResponseCompression(ctx, id, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceResponseCompressionRead(ctx, d, client)
+}
+
+func resourceResponseCompressionDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	if isServerScope(d) {
+		// The server settings cannot be removed, they are kept as they are
+		tflog.Debug(ctx, "Removing server response compression from state: "+toJSON(id))
+		return nil
+	}
+	tflog.Debug(ctx, "Reverting response compression to inherited settings: "+toJSON(id))
+	if err := client.DeleteResponseCompression(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// expandCompressionSchemes returns the configured schemes, settings which are
+// not configured are taken from the current scheme of the same name
+func expandCompressionSchemes(d *schema.ResourceData, current []iis.CompressionScheme) ([]iis.CompressionScheme, error) {
+	list := getList(d, "server.0.scheme")
+	schemes := make([]iis.CompressionScheme, 0, len(list))
+	for i := range list {
+		key := fmt.Sprintf("server.0.scheme.%d.", i)
+		scheme := iis.CompressionScheme{Name: d.Get(key + NameKey).(string)}
+		for _, existing := range current {
+			if strings.EqualFold(existing.Name, scheme.Name) {
+				scheme = existing
+			}
+		}
+		setConfiguredString(d, key+"dll", &scheme.Dll)
+		setConfiguredInt64(d, key+"dynamic_compression_level", &scheme.DynamicCompressionLevel)
+		setConfiguredInt64(d, key+"static_compression_level", &scheme.StaticCompressionLevel)
+		if scheme.Dll == "" {
+			return nil, fmt.Errorf("the dll of the new compression scheme %q is required", scheme.Name)
+		}
+		schemes = append(schemes, scheme)
+	}
+	return schemes, nil
+}
+
+func expandCompressionMimeTypes(types map[string]interface{}) []iis.CompressionMimeType {
+	mimeTypes := make([]iis.CompressionMimeType, 0, len(types))
+	for mimeType, enabled := range types {
+		mimeTypes = append(mimeTypes, iis.CompressionMimeType{MimeType: mimeType, Enabled: enabled.(bool)})
+	}
+	// IIS uses the first matching type, so wildcards like */* have to come last
+	sort.Slice(mimeTypes, func(i, j int) bool {
+		return compressionMimeTypeOrder(mimeTypes[i].MimeType) < compressionMimeTypeOrder(mimeTypes[j].MimeType)
+	})
+	return mimeTypes
+}
+
+// compressionMimeTypeOrder sorts exact types before type/* and */* wildcards
+func compressionMimeTypeOrder(mimeType string) string {
+	switch {
+	case mimeType == "*/*":
+		return "2"
+	case strings.HasSuffix(mimeType, "/*"):
+		return "1" + mimeType
+	}
+	return "0" + mimeType
+}
+
+func flattenCompressionMimeTypes(types []iis.CompressionMimeType) map[string]interface{} {
+	flattened := make(map[string]interface{}, len(types))
+	for _, mimeType := range types {
+		flattened[mimeType.MimeType] = mimeType.Enabled
+	}
+	return flattened
+}
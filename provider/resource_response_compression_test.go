@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceResponseCompression_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_response_compression" "server" {
+  do_dynamic_compression = true
+
+  server {
+    min_file_size_to_compress = 1024
+
+    scheme {
+      name                      = "gzip"
+      dynamic_compression_level = 4
+    }
+
+    dynamic_types = {
+      "text/*"           = true
+      "application/json" = true
+      "*/*"              = false
+    }
+  }
+}
+
+resource "iis_response_compression" "test" {
+  website                = iis_website.test.id
+  do_dynamic_compression = false
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_response_compression.server", "server.0.min_file_size_to_compress", "1024"),
+					resource.TestCheckResourceAttr("iis_response_compression.server", "server.0.scheme.0.static_compression_level", "7"),
+					resource.TestCheckResourceAttr("iis_response_compression.server", "server.0.dynamic_types.application/json", "true"),
+					resource.TestCheckResourceAttr("iis_response_compression.test", "do_static_compression", "true"),
+					resource.TestCheckResourceAttr("iis_response_compression.test", "do_dynamic_compression", "false"),
+				),
+			},
+			{
+				ResourceName:      "iis_response_compression.server",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "iis_response_compression.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+// w3cLogFields are the standard fields of the W3C log format
+var w3cLogFields = []string{
+	"date", "time", "client_ip", "username", "site_name", "computer_name", "server_ip", "method",
+	"uri_stem", "uri_query", "http_status", "http_sub_status", "win32_status", "bytes_sent", "bytes_recv",
+	"time_taken", "server_port", "user_agent", "cookie", "referer", "protocol_version", "host",
+}
+
+func resourceSiteLogging() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSiteLoggingCreate,
+		ReadContext:   resourceSiteLoggingRead,
+		UpdateContext: resourceSiteLoggingUpdate,
+		DeleteContext: resourceSiteLoggingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			WebsiteKey: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the website the logging is configured for, the server logging is managed if not set",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"log_per_site": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{WebsiteKey},
+				Description:   "Write a log file per website instead of one for the server, server level only",
+			},
+			"directory": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Directory the log files are written to, e.g. %SystemDrive%\\inetpub\\logs\\LogFiles",
+			},
+			"log_file_encoding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"utf-8", "ansi"}, false),
+			},
+			"log_file_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"w3c", "iis", "ncsa", "custom"}, false),
+			},
+			"log_target": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Where the requests are logged: file, etw or both",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"file", "etw"}, false),
+				},
+			},
+			"log_fields": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "W3C fields which are logged, e.g. date, time, client_ip and uri_stem",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(w3cLogFields, false),
+				},
+			},
+			"rollover": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"period": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"hourly", "daily", "weekly", "monthly", "max_size"}, false),
+							Description:  "Interval after which a new log file is started, max_size starts a new file when truncate_size is reached",
+						},
+						"truncate_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1048576),
+							Description:  "Size in bytes after which a new log file is started if the period is max_size",
+						},
+						"use_local_time": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Name and roll over the log files by local time instead of UTC",
+						},
+					},
+				},
+			},
+			"custom_field": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Additional fields of the W3C log, the current fields are kept if not set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"source_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the header or server variable, e.g. X-Forwarded-For",
+						},
+						"source_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"request_header", "response_header", "server_variable"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSiteLoggingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	// The logging of the server and each website always exists, creating the resource takes over its settings
+	logging, err := client.ReadLogging(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read logging: "+toJSON(logging))
+	d.SetId(logging.ID)
+	return updateSiteLogging(ctx, d, client)
+}
+
+func resourceSiteLoggingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	logging, err := client.ReadLoggingByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Logging not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read logging: "+toJSON(logging))
+	if err = setServerFeatureScope(ctx, d, client, logging.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+
+	targets := make([]string, 0, 2)
+	if logging.LogTarget.File {
+		targets = append(targets, "file")
+	}
+	if logging.LogTarget.ETW {
+		targets = append(targets, "etw")
+	}
+	fields := make([]string, 0, len(logging.LogFields))
+	for field, enabled := range logging.LogFields {
+		if enabled {
+			fields = append(fields, field)
+		}
+	}
+	customFields := make([]interface{}, 0, len(logging.CustomLogFields))
+	for _, field := range logging.CustomLogFields {
+		customFields = append(customFields, map[string]interface{}{
+			"field_name":  field.FieldName,
+			"source_name": field.SourceName,
+			"source_type": field.SourceType,
+		})
+	}
+	values := map[string]interface{}{
+		"enabled":           logging.Enabled,
+		"directory":         logging.Directory,
+		"log_file_encoding": logging.LogFileEncoding,
+		"log_file_format":   logging.LogFileFormat,
+		"log_target":        targets,
+		"log_fields":        fields,
+		"rollover": []interface{}{map[string]interface{}{
+			"period":         logging.Rollover.Period,
+			"truncate_size":  logging.Rollover.TruncateSize,
+			"use_local_time": logging.Rollover.UseLocalTime,
+		}},
+		"custom_field": customFields,
+	}
+	if isServerScope(d) && logging.LogPerSite != nil {
+		values["log_per_site"] = *logging.LogPerSite
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceSiteLoggingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateSiteLogging(ctx, d, m.(*iis.Client))
+}
+
+func updateSiteLogging(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChangesExcept(WebsiteKey) {
+		logging, err := client.ReadLoggingByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setConfiguredBool(d, "enabled", &logging.Enabled)
+		setConfiguredString(d, "directory", &logging.Directory)
+		setConfiguredString(d, "log_file_encoding", &logging.LogFileEncoding)
+		setConfiguredString(d, "log_file_format", &logging.LogFileFormat)
+		if isConfigured(d, "log_target") {
+			targets := d.Get("log_target").(*schema.Set)
+			logging.LogTarget = iis.LogTarget{File: targets.Contains("file"), ETW: targets.Contains("etw")}
+		}
+		if isConfigured(d, "log_fields") {
+			// All known fields are sent, so fields which are not configured are disabled
+			fields := d.Get("log_fields").(*schema.Set)
+			logging.LogFields = make(map[string]bool, len(w3cLogFields))
+			for _, field := range w3cLogFields {
+				logging.LogFields[field] = fields.Contains(field)
+			}
+		}
+		setConfiguredString(d, "rollover.0.period", &logging.Rollover.Period)
+		setConfiguredInt64(d, "rollover.0.truncate_size", &logging.Rollover.TruncateSize)
+		setConfiguredBool(d, "rollover.0.use_local_time", &logging.Rollover.UseLocalTime)
+		if isConfigured(d, "custom_field") {
+			logging.CustomLogFields = make([]iis.CustomLogField, 0)
+			for _, item := range getList(d, "custom_field") {
+				field := item.(map[string]interface{})
+				logging.CustomLogFields = append(logging.CustomLogFields, iis.CustomLogField{
+					FieldName:  field["field_name"].(string),
+					SourceName: field["source_name"].(string),
+					SourceType: field["source_type"].(string),
+				})
+			}
+		}
+		// log_per_site is locked below the server level
+		logging.LogPerSite = nil
+		if isServerScope(d) && isConfigured(d, "log_per_site") {
+			logPerSite := d.Get("log_per_site").(bool)
+			logging.LogPerSite = &logPerSite
+		}
+		tflog.Debug(ctx, "Updating logging: "+toJSON(logging))
+		if _, err = client.UpdateLogging(ctx, *logging); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceSiteLoggingRead(ctx, d, client)
+}
+
+func resourceSiteLoggingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	if isServerScope(d) {
+		// The server logging cannot be removed, it is kept as it is
+		tflog.Debug(ctx, "Removing server logging from state: "+toJSON(id))
+		return nil
+	}
+	tflog.Debug(ctx, "Reverting logging to inherited settings: "+toJSON(id))
+	if err := client.DeleteLogging(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSiteLogging_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_site_logging" "test" {
+  website    = iis_website.test.id
+  directory  = "D:\\logs"
+  log_fields = ["date", "time", "client_ip", "method", "uri_stem", "http_status", "time_taken"]
+
+  rollover {
+    period         = "hourly"
+    use_local_time = true
+  }
+
+  custom_field {
+    field_name  = "X-Forwarded-For"
+    source_name = "X-Forwarded-For"
+    source_type = "request_header"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_site_logging.test", "directory", "D:\\logs"),
+					resource.TestCheckResourceAttr("iis_site_logging.test", "log_fields.#", "7"),
+					resource.TestCheckResourceAttr("iis_site_logging.test", "log_file_format", "w3c"),
+					resource.TestCheckResourceAttr("iis_site_logging.test", "rollover.0.period", "hourly"),
+					resource.TestCheckResourceAttr("iis_site_logging.test", "custom_field.0.source_type", "request_header"),
+				),
+			},
+			{
+				ResourceName:      "iis_site_logging.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceSSLSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSSLSettingsCreate,
+		ReadContext:   resourceSSLSettingsRead,
+		UpdateContext: resourceSSLSettingsUpdate,
+		DeleteContext: resourceSSLSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"require_ssl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Reject requests which are not sent over https",
+			},
+			"client_certificates": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ignore", "accept", "require"}, false),
+				Description:  "Whether clients have to present a certificate: ignore, accept or require",
+			},
+		}),
+	}
+}
+
+func resourceSSLSettingsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	settings, err := client.ReadSSLSettings(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read ssl settings: "+toJSON(settings))
+	d.SetId(settings.ID)
+	return updateSSLSettings(ctx, d, client)
+}
+
+func resourceSSLSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	settings, err := client.ReadSSLSettingsByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Ssl settings not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read ssl settings: "+toJSON(settings))
+	if err = setFeatureScope(ctx, d, client, settings.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+
+	values := map[string]interface{}{
+		"require_ssl":         settings.RequireSSL,
+		"client_certificates": settings.ClientCertificates,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceSSLSettingsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateSSLSettings(ctx, d, m.(*iis.Client))
+}
+
+func updateSSLSettings(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChanges("require_ssl", "client_certificates") {
+		settings, err := client.ReadSSLSettingsByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setConfiguredBool(d, "require_ssl", &settings.RequireSSL)
+		setConfiguredString(d, "client_certificates", &settings.ClientCertificates)
+		tflog.Debug(ctx, "Updating ssl settings: "+toJSON(settings))
+		if _, err = client.UpdateSSLSettings(ctx, *settings); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceSSLSettingsRead(ctx, d, client)
+}
+
+func resourceSSLSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Reverting ssl settings to inherited settings: "+toJSON(id))
+	if err := client.DeleteSSLSettings(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceSSLSettings_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceSSLSettingsConfig("accept"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_ssl_settings.test", "require_ssl", "true"),
+					resource.TestCheckResourceAttr("iis_ssl_settings.test", "client_certificates", "accept"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceSSLSettingsConfig("require"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_ssl_settings.test", "client_certificates", "require"),
+				),
+			},
+			{
+				ResourceName:      "iis_ssl_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSSLSettingsConfig(clientCertificates string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_ssl_settings" "test" {
+  website             = iis_website.test.id
+  require_ssl         = true
+  client_certificates = %q
+}
+`, clientCertificates)
+}
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+var staticContentClientCacheSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"control_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice([]string{"no_control", "disable_cache", "use_max_age", "use_expires"}, false),
+			Description:  "Cache-Control header sent with static files: no_control, disable_cache, use_max_age or use_expires",
+		},
+		"max_age": timeSpanSchema("Max age of the use_max_age mode (e.g. 168h or P7D)"),
+		"http_expires": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "RFC 1123 date of the use_expires mode, e.g. Tue, 19 Jan 2038 03:14:07 GMT",
+		},
+		"control_custom": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Additional Cache-Control directives, e.g. public or immutable",
+		},
+		"set_etag": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+	},
+}
+
+func resourceStaticContent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStaticContentCreate,
+		ReadContext:   resourceStaticContentRead,
+		UpdateContext: resourceStaticContentUpdate,
+		DeleteContext: resourceStaticContentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withServerFeatureScope(map[string]*schema.Schema{
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      additiveMode,
+				ValidateFunc: validation.StringInSlice([]string{authoritativeMode, additiveMode}, false),
+				Description:  "additive only manages the configured mime maps, authoritative also removes the inherited mime maps which are not configured",
+			},
+			"client_cache": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem:     staticContentClientCacheSchema,
+			},
+			"mime_map": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"file_extension": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringMatch(fileExtensionPattern, "must start with '.', e.g. .wasm"),
+						},
+						"mime_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceStaticContentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	content, err := client.ReadStaticContent(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read static content: "+toJSON(content))
+	d.SetId(content.ID)
+	return updateStaticContent(ctx, d, client)
+}
+
+func resourceStaticContentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	content, err := client.ReadStaticContentByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Static content not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read static content: "+toJSON(content))
+	if err = setServerFeatureScope(ctx, d, client, content.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+	mode := d.Get("mode").(string)
+	if mode == "" {
+		// Imported resources manage all mime maps
+		mode = authoritativeMode
+	}
+	mimeMaps, err := client.ListMimeMaps(ctx, content.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entries := newMimeMapEntries(ctx, client, content.ID)
+
+	values := map[string]interface{}{
+		"mode": mode,
+		"client_cache": []interface{}{map[string]interface{}{
+			"control_mode":   content.ClientCache.ControlMode,
+			"max_age":        iis.FormatTimeSpan(content.ClientCache.MaxAge.Duration()),
+			"http_expires":   content.ClientCache.HttpExpires,
+			"control_custom": content.ClientCache.ControlCustom,
+			"set_etag":       content.ClientCache.SetETag,
+		}},
+		"mime_map": flattenMimeMaps(entries.filter(mimeMaps, expandMimeMaps(d.Get("mime_map")), mode == authoritativeMode)),
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceStaticContentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateStaticContent(ctx, d, m.(*iis.Client))
+}
+
+func updateStaticContent(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChange("client_cache") {
+		content, err := client.ReadStaticContentByID(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		setConfiguredString(d, "client_cache.0.control_mode", &content.ClientCache.ControlMode)
+		setConfiguredTimeSpan(d, "client_cache.0.max_age", (*time.Duration)(&content.ClientCache.MaxAge))
+		setConfiguredString(d, "client_cache.0.http_expires", &content.ClientCache.HttpExpires)
+		setConfiguredString(d, "client_cache.0.control_custom", &content.ClientCache.ControlCustom)
+		setConfiguredBool(d, "client_cache.0.set_etag", &content.ClientCache.SetETag)
+		tflog.Debug(ctx, "Updating static content: "+toJSON(content))
+		if _, err = client.UpdateStaticContent(ctx, *content); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.IsNewResource() || d.HasChanges("mime_map", "mode") {
+		current, err := client.ListMimeMaps(ctx, id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		old, _ := d.GetChange("mime_map")
+		authoritative := d.Get("mode").(string) == authoritativeMode
+		if err = newMimeMapEntries(ctx, client, id).reconcile(current, expandMimeMaps(old), expandMimeMaps(d.Get("mime_map")), authoritative); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceStaticContentRead(ctx, d, client)
+}
+
+func resourceStaticContentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	if d.Get("mode").(string) == authoritativeMode {
+		if isServerScope(d) {
+			// The server settings cannot be removed, they are kept as they are
+			tflog.Debug(ctx, "Removing server static content from state: "+toJSON(id))
+			return nil
+		}
+		tflog.Debug(ctx, "Reverting static content to inherited settings: "+toJSON(id))
+		if err := client.DeleteStaticContent(ctx, id); err != nil && !iis.IsNotFoundError(err) {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	// Only the managed mime maps are removed in additive mode, the client cache settings are kept
+	tflog.Debug(ctx, "Removing managed mime maps: "+toJSON(id))
+	mimeMaps, err := client.ListMimeMaps(ctx, id)
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if err = newMimeMapEntries(ctx, client, id).reconcile(mimeMaps, expandMimeMaps(d.Get("mime_map")), nil, false); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func newMimeMapEntries(ctx context.Context, client *iis.Client, id string) featureEntries[iis.MimeMap] {
+	return featureEntries[iis.MimeMap]{
+		key:   func(mimeMap iis.MimeMap) string { return mimeMap.FileExtension },
+		id:    func(mimeMap iis.MimeMap) string { return mimeMap.ID },
+		equal: func(a, b iis.MimeMap) bool { return a.MimeType == b.MimeType },
+		create: func(mimeMap iis.MimeMap) error {
+			tflog.Debug(ctx, "Adding mime map: "+toJSON(mimeMap))
+			_, err := client.CreateMimeMap(ctx, id, mimeMap)
+			return err
+		},
+		update: func(entryID string, mimeMap iis.MimeMap) error {
+			tflog.Debug(ctx, "Updating mime map: "+toJSON(mimeMap))
+			_, err := client.UpdateMimeMap(ctx, entryID, mimeMap)
+			return err
+		},
+		remove: func(entryID string) error {
+			tflog.Debug(ctx, "Removing mime map: "+toJSON(entryID))
+			return client.DeleteMimeMap(ctx, entryID)
+		},
+	}
+}
+
+func expandMimeMaps(value interface{}) []iis.MimeMap {
+	mimeMaps := make([]iis.MimeMap, 0)
+	for _, item := range value.(*schema.Set).List() {
+		mimeMap := item.(map[string]interface{})
+		mimeMaps = append(mimeMaps, iis.MimeMap{FileExtension: mimeMap["file_extension"].(string), MimeType: mimeMap["mime_type"].(string)})
+	}
+	return mimeMaps
+}
+
+func flattenMimeMaps(mimeMaps []iis.MimeMap) []interface{} {
+	flattened := make([]interface{}, 0, len(mimeMaps))
+	for _, mimeMap := range mimeMaps {
+		flattened = append(flattened, map[string]interface{}{"file_extension": mimeMap.FileExtension, "mime_type": mimeMap.MimeType})
+	}
+	return flattened
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceStaticContent_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceStaticContentConfig("P7D"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_static_content.test", "client_cache.0.control_mode", "use_max_age"),
+					resource.TestCheckResourceAttr("iis_static_content.test", "client_cache.0.max_age", "168h"),
+					resource.TestCheckResourceAttr("iis_static_content.test", "mime_map.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("iis_static_content.test", "mime_map.*", map[string]string{
+						"file_extension": ".wasm",
+						"mime_type":      "application/wasm",
+					}),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceStaticContentConfig("1h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_static_content.test", "client_cache.0.max_age", "1h"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceStaticContentConfig(maxAge string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_static_content" "test" {
+  website = iis_website.test.id
+
+  client_cache {
+    control_mode = "use_max_age"
+    max_age      = %q
+  }
+
+  mime_map {
+    file_extension = ".wasm"
+    mime_type      = "application/wasm"
+  }
+
+  mime_map {
+    file_extension = ".webmanifest"
+    mime_type      = "application/manifest+json"
+  }
+}
+`, maxAge)
+}
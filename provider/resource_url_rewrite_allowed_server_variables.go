@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceUrlRewriteAllowedServerVariables() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteAllowedServerVariablesCreate,
+		ReadContext:   resourceUrlRewriteAllowedServerVariablesRead,
+		UpdateContext: resourceUrlRewriteAllowedServerVariablesUpdate,
+		DeleteContext: resourceUrlRewriteAllowedServerVariablesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			"variables": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Server variables inbound rules may set, e.g. HTTP_X_FORWARDED_PROTO or RESPONSE_Server",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		}),
+	}
+}
+
+func resourceUrlRewriteAllowedServerVariablesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	// The allowed server variables of a scope always exist, creating the resource takes over their settings
+	variables, err := client.ReadAllowedServerVariables(ctx, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read allowed server variables: "+toJSON(variables))
+	d.SetId(variables.ID)
+	return updateUrlRewriteAllowedServerVariables(ctx, d, client)
+}
+
+func resourceUrlRewriteAllowedServerVariablesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	variables, err := client.ReadAllowedServerVariablesByID(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Allowed server variables not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read allowed server variables: "+toJSON(variables))
+
+	if err = setFeatureScope(ctx, d, client, variables.Feature); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("variables", variables.Entries); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceUrlRewriteAllowedServerVariablesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return updateUrlRewriteAllowedServerVariables(ctx, d, m.(*iis.Client))
+}
+
+func updateUrlRewriteAllowedServerVariables(ctx context.Context, d *schema.ResourceData, client *iis.Client) diag.Diagnostics {
+	id := d.Id()
+	if d.IsNewResource() || d.HasChange("variables") {
+		request := iis.UpdateAllowedServerVariablesRequest{
+			Entries: toStrings(d.Get("variables").(*schema.Set).List()),
+		}
+		tflog.Debug(ctx, "Updating allowed server variables: "+toJSON(request))
+		if _, err := client.UpdateAllowedServerVariables(ctx, id, request); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceUrlRewriteAllowedServerVariablesRead(ctx, d, client)
+}
+
+func resourceUrlRewriteAllowedServerVariablesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Reverting allowed server variables to inherited settings: "+toJSON(id))
+	err := client.DeleteAllowedServerVariables(ctx, id)
+	if err != nil && !iis.IsNotFoundError(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
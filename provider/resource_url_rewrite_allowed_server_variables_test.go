@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceURLRewriteAllowedServerVariables_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceUrlRewriteAllowedServerVariablesConfig("HTTP_X_ORIGINAL_URL"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_url_rewrite_allowed_server_variables.test", "variables.#", "1"),
+					resource.TestCheckTypeSetElemAttr("iis_url_rewrite_allowed_server_variables.test", "variables.*", "HTTP_X_ORIGINAL_URL"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceUrlRewriteAllowedServerVariablesConfig("HTTP_X_FORWARDED_PROTO", "RESPONSE_Server"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_url_rewrite_allowed_server_variables.test", "variables.#", "2"),
+					resource.TestCheckTypeSetElemAttr("iis_url_rewrite_allowed_server_variables.test", "variables.*", "HTTP_X_FORWARDED_PROTO"),
+					resource.TestCheckTypeSetElemAttr("iis_url_rewrite_allowed_server_variables.test", "variables.*", "RESPONSE_Server"),
+				),
+			},
+			{
+				ResourceName:      "iis_url_rewrite_allowed_server_variables.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceUrlRewriteAllowedServerVariablesConfig(variables ...string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_url_rewrite_allowed_server_variables" "test" {
+  website   = iis_website.test.id
+  variables = ["%s"]
+}
+`, strings.Join(variables, `", "`))
+}
@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceUrlRewriteInboundRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteInboundRuleCreate,
+		ReadContext:   resourceUrlRewriteInboundRuleRead,
+		UpdateContext: resourceUrlRewriteInboundRuleUpdate,
+		DeleteContext: resourceUrlRewriteInboundRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withUrlRewriteRule(map[string]*schema.Schema{
+			"server_variable": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Server variables set when the rule matches, they have to be allowed with iis_url_rewrite_allowed_server_variables",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						NameKey: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"replace": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"rewrite", "redirect", "custom_response", "abort_request", "none"}, false),
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Target of a rewrite or redirect, e.g. https://{HTTP_HOST}/{R:1}",
+						},
+						"append_query_string": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"log_rewritten_url": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"redirect_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "permanent",
+							ValidateFunc: validation.StringInSlice([]string{"permanent", "found", "see_other", "temporary"}, false),
+						},
+						"status_code": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Status code of a custom response",
+						},
+						"sub_status_code": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"status_reason": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceUrlRewriteInboundRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	section, err := client.ReadUrlRewriteSection(ctx, iis.InboundRulesSection, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule := expandInboundRule(d)
+	tflog.Debug(ctx, "Creating inbound rule: "+toJSON(rule))
+	created, err := client.CreateInboundRule(ctx, section.ID, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created inbound rule: "+toJSON(created))
+	d.SetId(created.ID)
+	return resourceUrlRewriteInboundRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteInboundRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	rule, err := client.ReadInboundRule(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Inbound rule not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read inbound rule: "+toJSON(rule))
+	if err = setUrlRewriteScope(ctx, d, client, iis.InboundRulesSection, rule.InboundRules); err != nil {
+		return diag.FromErr(err)
+	}
+
+	serverVariables := make([]interface{}, 0, len(rule.ServerVariables))
+	for _, variable := range rule.ServerVariables {
+		serverVariables = append(serverVariables, map[string]interface{}{
+			NameKey:   variable.Name,
+			"value":   variable.Value,
+			"replace": variable.Replace,
+		})
+	}
+	values := map[string]interface{}{
+		NameKey:                       rule.Name,
+		"priority":                    flattenPriority(rule.Priority),
+		"pattern":                     rule.Pattern,
+		"pattern_syntax":              rule.PatternSyntax,
+		"ignore_case":                 rule.IgnoreCase,
+		"negate":                      rule.Negate,
+		"stop_processing":             rule.StopProcessing,
+		"condition_match_constraints": rule.ConditionMatchConstraints,
+		"track_all_captures":          rule.TrackAllCaptures,
+		"condition":                   flattenRewriteConditions(rule.Conditions),
+		"server_variable":             serverVariables,
+		"action": []interface{}{map[string]interface{}{
+			"type":                rule.Action.Type,
+			"url":                 rule.Action.Url,
+			"append_query_string": rule.Action.AppendQueryString,
+			"log_rewritten_url":   rule.Action.LogRewrittenUrl,
+			"redirect_type":       rule.Action.RedirectType,
+			"status_code":         rule.Action.StatusCode,
+			"sub_status_code":     rule.Action.SubStatusCode,
+			"status_reason":       rule.Action.StatusReason,
+			"description":         rule.Action.Description,
+		}},
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceUrlRewriteInboundRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	rule := expandInboundRule(d)
+	tflog.Debug(ctx, "Updating inbound rule: "+toJSON(rule))
+	updated, err := client.UpdateInboundRule(ctx, id, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated inbound rule: "+toJSON(updated))
+	return resourceUrlRewriteInboundRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteInboundRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting inbound rule: "+toJSON(id))
+	err := client.DeleteInboundRule(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted inbound rule: "+toJSON(id))
+	return nil
+}
+
+func expandInboundRule(d *schema.ResourceData) iis.InboundRule {
+	serverVariables := make([]iis.RewriteServerVariable, 0)
+	for _, item := range getList(d, "server_variable") {
+		variable := item.(map[string]interface{})
+		serverVariables = append(serverVariables, iis.RewriteServerVariable{
+			Name:    variable[NameKey].(string),
+			Value:   variable["value"].(string),
+			Replace: variable["replace"].(bool),
+		})
+	}
+	action := getNestedMap(d, "action")
+	return iis.InboundRule{
+		Name:                      d.Get(NameKey).(string),
+		Priority:                  getPriority(d),
+		Pattern:                   d.Get("pattern").(string),
+		PatternSyntax:             d.Get("pattern_syntax").(string),
+		IgnoreCase:                d.Get("ignore_case").(bool),
+		Negate:                    d.Get("negate").(bool),
+		StopProcessing:            d.Get("stop_processing").(bool),
+		ConditionMatchConstraints: d.Get("condition_match_constraints").(string),
+		TrackAllCaptures:          d.Get("track_all_captures").(bool),
+		Conditions:                expandRewriteConditions(getList(d, "condition")),
+		ServerVariables:           serverVariables,
+		Action: iis.InboundRuleAction{
+			Type:              action["type"].(string),
+			Url:               action["url"].(string),
+			AppendQueryString: action["append_query_string"].(bool),
+			LogRewrittenUrl:   action["log_rewritten_url"].(bool),
+			RedirectType:      action["redirect_type"].(string),
+			StatusCode:        int64(action["status_code"].(int)),
+			SubStatusCode:     int64(action["sub_status_code"].(int)),
+			StatusReason:      action["status_reason"].(string),
+			Description:       action["description"].(string),
+		},
+	}
+}
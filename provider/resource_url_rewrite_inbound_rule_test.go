@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUrlRewriteInboundRule_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceUrlRewriteInboundRuleConfig(""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_url_rewrite_inbound_rule.https", "priority", "0"),
+					resource.TestCheckResourceAttr("iis_url_rewrite_inbound_rule.https", "condition.0.input", "{HTTPS}"),
+					resource.TestCheckResourceAttr("iis_url_rewrite_inbound_rule.https", "action.0.redirect_type", "permanent"),
+					resource.TestCheckResourceAttr("iis_url_rewrite_inbound_rule.legacy", "priority", "1"),
+				),
+			},
+			{
+				// Moving the legacy rule in front of the redirect
+				Config: testAccProviderConfig(server) + testAccResourceUrlRewriteInboundRuleConfig("priority = 0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_url_rewrite_inbound_rule.legacy", "priority", "0"),
+				),
+			},
+			{
+				ResourceName:      "iis_url_rewrite_inbound_rule.https",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceUrlRewriteInboundRuleConfig(priority string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_url_rewrite_inbound_rule" "https" {
+  website         = iis_website.test.id
+  name            = "Redirect to HTTPS"
+  pattern         = "(.*)"
+  stop_processing = true
+
+  condition {
+    input   = "{HTTPS}"
+    pattern = "^OFF$"
+  }
+
+  action {
+    type = "redirect"
+    url  = "https://{HTTP_HOST}/{R:1}"
+  }
+}
+
+resource "iis_url_rewrite_inbound_rule" "legacy" {
+  website = iis_website.test.id
+  name    = "Legacy"
+  pattern = "^legacy/(.*)"
+  %s
+
+  action {
+    type = "rewrite"
+    url  = "v1/{R:1}"
+  }
+
+  depends_on = [iis_url_rewrite_inbound_rule.https]
+}
+`, priority)
+}
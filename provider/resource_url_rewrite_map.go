@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func resourceUrlRewriteMap() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteMapCreate,
+		ReadContext:   resourceUrlRewriteMapRead,
+		UpdateContext: resourceUrlRewriteMapUpdate,
+		DeleteContext: resourceUrlRewriteMapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withFeatureScope(map[string]*schema.Schema{
+			NameKey: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"default_value": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Value returned for keys which are not mapped",
+			},
+			"ignore_case": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceUrlRewriteMapCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	section, err := client.ReadUrlRewriteSection(ctx, iis.RewriteMapsSection, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rewriteMap := expandRewriteMap(d)
+	tflog.Debug(ctx, "Creating rewrite map: "+toJSON(rewriteMap.Name))
+	created, err := client.CreateRewriteMap(ctx, section.ID, rewriteMap)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created rewrite map: "+toJSON(created.ID))
+	d.SetId(created.ID)
+	return resourceUrlRewriteMapRead(ctx, d, m)
+}
+
+func resourceUrlRewriteMapRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	rewriteMap, err := client.ReadRewriteMap(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Rewrite map not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read rewrite map: "+toJSON(rewriteMap.Name))
+	if err = setUrlRewriteScope(ctx, d, client, iis.RewriteMapsSection, rewriteMap.RewriteMaps); err != nil {
+		return diag.FromErr(err)
+	}
+
+	mappings := make([]interface{}, 0, len(rewriteMap.Mappings))
+	for _, mapping := range rewriteMap.Mappings {
+		mappings = append(mappings, map[string]interface{}{
+			"key":   mapping.Key,
+			"value": mapping.Value,
+		})
+	}
+	values := map[string]interface{}{
+		NameKey:         rewriteMap.Name,
+		"default_value": rewriteMap.DefaultValue,
+		"ignore_case":   rewriteMap.IgnoreCase,
+		"mapping":       mappings,
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceUrlRewriteMapUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	rewriteMap := expandRewriteMap(d)
+	tflog.Debug(ctx, "Updating rewrite map: "+toJSON(id))
+	if _, err := client.UpdateRewriteMap(ctx, id, rewriteMap); err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated rewrite map: "+toJSON(id))
+	return resourceUrlRewriteMapRead(ctx, d, m)
+}
+
+func resourceUrlRewriteMapDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting rewrite map: "+toJSON(id))
+	err := client.DeleteRewriteMap(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted rewrite map: "+toJSON(id))
+	return nil
+}
+
+func expandRewriteMap(d *schema.ResourceData) iis.RewriteMap {
+	mappings := make([]iis.RewriteMapping, 0)
+	for _, item := range d.Get("mapping").(*schema.Set).List() {
+		mapping := item.(map[string]interface{})
+		mappings = append(mappings, iis.RewriteMapping{
+			Key:   mapping["key"].(string),
+			Value: mapping["value"].(string),
+		})
+	}
+	return iis.RewriteMap{
+		Name:         d.Get(NameKey).(string),
+		DefaultValue: d.Get("default_value").(string),
+		IgnoreCase:   d.Get("ignore_case").(bool),
+		Mappings:     mappings,
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUrlRewriteMap_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_url_rewrite_allowed_server_variables" "test" {
+  website   = iis_website.test.id
+  variables = ["HTTP_X_ORIGINAL_URL"]
+}
+
+resource "iis_url_rewrite_map" "redirects" {
+  website = iis_website.test.id
+  name    = "Redirects"
+
+  mapping {
+    key   = "/old"
+    value = "/new"
+  }
+
+  mapping {
+    key   = "/about-us"
+    value = "/about"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_url_rewrite_map.redirects", "mapping.#", "2"),
+					resource.TestCheckResourceAttr("iis_url_rewrite_allowed_server_variables.test", "variables.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "iis_url_rewrite_map.redirects",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "iis_url_rewrite_allowed_server_variables.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+var outboundRuleTags = []string{"a", "area", "base", "form", "frame", "head", "iframe", "img", "input", "link", "script"}
+
+func resourceUrlRewriteOutboundRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUrlRewriteOutboundRuleCreate,
+		ReadContext:   resourceUrlRewriteOutboundRuleRead,
+		UpdateContext: resourceUrlRewriteOutboundRuleUpdate,
+		DeleteContext: resourceUrlRewriteOutboundRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: withUrlRewriteRule(map[string]*schema.Schema{
+			"precondition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the precondition a response has to match, e.g. IsHTML",
+			},
+			"match_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "server_variable",
+				ValidateFunc: validation.StringInSlice([]string{"server_variable", "tags"}, false),
+				Description:  "Whether the rule rewrites a server variable (including response headers) or html tags of the response",
+			},
+			"server_variable": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Server variable the rule rewrites, response headers are prefixed with RESPONSE_, e.g. RESPONSE_Server",
+			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Html tags whose urls the rule rewrites",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(outboundRuleTags, false),
+				},
+			},
+			"action": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"rewrite", "none"}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"replace_server_variable": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		}),
+	}
+}
+
+func resourceUrlRewriteOutboundRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	section, err := client.ReadUrlRewriteSection(ctx, iis.OutboundRulesSection, getFeatureScope(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rule := expandOutboundRule(d)
+	tflog.Debug(ctx, "Creating outbound rule: "+toJSON(rule))
+	created, err := client.CreateOutboundRule(ctx, section.ID, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created outbound rule: "+toJSON(created))
+	d.SetId(created.ID)
+	return resourceUrlRewriteOutboundRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteOutboundRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	rule, err := client.ReadOutboundRule(ctx, d.Id())
+	if err != nil {
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Outbound rule not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read outbound rule: "+toJSON(rule))
+	if err = setUrlRewriteScope(ctx, d, client, iis.OutboundRulesSection, rule.OutboundRules); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tags := make([]string, 0, len(rule.TagFilters))
+	for tag, enabled := range rule.TagFilters {
+		if enabled {
+			tags = append(tags, tag)
+		}
+	}
+	values := map[string]interface{}{
+		NameKey:                       rule.Name,
+		"priority":                    flattenPriority(rule.Priority),
+		"precondition":                rule.Precondition,
+		"match_type":                  rule.MatchType,
+		"server_variable":             rule.ServerVariable,
+		"tags":                        tags,
+		"pattern":                     rule.Pattern,
+		"pattern_syntax":              rule.PatternSyntax,
+		"ignore_case":                 rule.IgnoreCase,
+		"negate":                      rule.Negate,
+		"stop_processing":             rule.StopProcessing,
+		"condition_match_constraints": rule.ConditionMatchConstraints,
+		"track_all_captures":          rule.TrackAllCaptures,
+		"condition":                   flattenRewriteConditions(rule.Conditions),
+		"action": []interface{}{map[string]interface{}{
+			"type":                    rule.Action.Type,
+			"value":                   rule.Action.Value,
+			"replace_server_variable": rule.Action.ReplaceServerVariable,
+		}},
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceUrlRewriteOutboundRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	rule := expandOutboundRule(d)
+	tflog.Debug(ctx, "Updating outbound rule: "+toJSON(rule))
+	updated, err := client.UpdateOutboundRule(ctx, id, rule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Updated outbound rule: "+toJSON(updated))
+	return resourceUrlRewriteOutboundRuleRead(ctx, d, m)
+}
+
+func resourceUrlRewriteOutboundRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting outbound rule: "+toJSON(id))
+	err := client.DeleteOutboundRule(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted outbound rule: "+toJSON(id))
+	return nil
+}
+
+func expandOutboundRule(d *schema.ResourceData) iis.OutboundRule {
+	// IIS lists all tags with a flag, unselected tags are sent as false
+	tags := d.Get("tags").(*schema.Set)
+	tagFilters := make(map[string]bool, len(outboundRuleTags))
+	for _, tag := range outboundRuleTags {
+		tagFilters[tag] = tags.Contains(tag)
+	}
+	action := getNestedMap(d, "action")
+	return iis.OutboundRule{
+		Name:                      d.Get(NameKey).(string),
+		Priority:                  getPriority(d),
+		Precondition:              d.Get("precondition").(string),
+		MatchType:                 d.Get("match_type").(string),
+		ServerVariable:            d.Get("server_variable").(string),
+		TagFilters:                tagFilters,
+		Pattern:                   d.Get("pattern").(string),
+		PatternSyntax:             d.Get("pattern_syntax").(string),
+		IgnoreCase:                d.Get("ignore_case").(bool),
+		Negate:                    d.Get("negate").(bool),
+		StopProcessing:            d.Get("stop_processing").(bool),
+		ConditionMatchConstraints: d.Get("condition_match_constraints").(string),
+		TrackAllCaptures:          d.Get("track_all_captures").(bool),
+		Conditions:                expandRewriteConditions(getList(d, "condition")),
+		Action: iis.OutboundRuleAction{
+			Type:                  action["type"].(string),
+			Value:                 action["value"].(string),
+			ReplaceServerVariable: action["replace_server_variable"].(bool),
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceUrlRewriteOutboundRule_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + `
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_url_rewrite_outbound_rule" "links" {
+  website    = iis_website.test.id
+  name       = "Prefix links"
+  match_type = "tags"
+  tags       = ["a", "img"]
+  pattern    = "^/(.*)"
+
+  action {
+    type  = "rewrite"
+    value = "/app/{R:1}"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_url_rewrite_outbound_rule.links", "tags.#", "2"),
+					resource.TestCheckResourceAttr("iis_url_rewrite_outbound_rule.links", "action.0.value", "/app/{R:1}"),
+				),
+			},
+			{
+				ResourceName:      "iis_url_rewrite_outbound_rule.links",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+const ApplicationKey = "application"
+
+var virtualPathPattern = regexp.MustCompile(`^/[^/]`)
+
+func resourceVirtualDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVirtualDirectoryCreate,
+		ReadContext:   resourceVirtualDirectoryRead,
+		UpdateContext: resourceVirtualDirectoryUpdate,
+		DeleteContext: resourceVirtualDirectoryDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			PathKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(virtualPathPattern, "must start with '/', e.g. /images"),
+				Description:  "Path of the virtual directory relative to the website or application, e.g. /images",
+			},
+			PhysicalPathKey: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			WebsiteKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{WebsiteKey, ApplicationKey},
+				Description:  "ID of the website, the virtual directory is created below its root application",
+			},
+			ApplicationKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{WebsiteKey, ApplicationKey},
+				Description:  "ID of the application the virtual directory is created below",
+			},
+			"identity": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Connect-as user for accessing the physical path, the application user (pass-through authentication) is used if not set",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"logon_method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"interactive", "batch", "network", "network_cleartext"}, false),
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
+							Description: "Password of the user, it is never stored in state and only sent on create or when password_version changes",
+						},
+						"password_version": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Arbitrary value (e.g. a counter or hash) which has to be changed to send a new password",
+						},
+					},
+				},
+			},
+			"location": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVirtualDirectoryCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	request := iis.CreateVirtualDirectoryRequest{
+		Path:         d.Get(PathKey).(string),
+		PhysicalPath: d.Get(PhysicalPathKey).(string),
+	}
+	if id := d.Get(ApplicationKey).(string); id != "" {
+		request.Application = &iis.Reference{ID: id}
+	} else {
+		request.Website = &iis.Reference{ID: d.Get(WebsiteKey).(string)}
+	}
+	identity, diags := expandVirtualDirectoryIdentity(d)
+	if diags.HasError() {
+		return diags
+	}
+	request.Identity = identity
+	tflog.Debug(ctx, "Creating virtual directory: "+toJSON(request.Path))
+	vdir, err := client.CreateVirtualDirectory(ctx, request)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Created virtual directory: "+toJSON(vdir))
+	d.SetId(vdir.ID)
+	return resourceVirtualDirectoryRead(ctx, d, m)
+}
+
+func resourceVirtualDirectoryRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	vdir, err := client.ReadVirtualDirectory(ctx, d.Id())
+	if err != nil {
+		// If resource was manually deleted (404), remove from state
+		if iis.IsNotFoundError(err) {
+			tflog.Warn(ctx, "Virtual directory not found, removing from state: "+d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Read virtual directory: "+toJSON(vdir))
+
+	values := map[string]interface{}{
+		PathKey:         vdir.Path,
+		PhysicalPathKey: vdir.PhysicalPath,
+		"location":      vdir.Location,
+	}
+	// Virtual directories of a website belong to its root application
+	if vdir.Application.Path == "/" {
+		values[WebsiteKey] = vdir.Website.ID
+		values[ApplicationKey] = ""
+	} else {
+		values[WebsiteKey] = ""
+		values[ApplicationKey] = vdir.Application.ID
+	}
+	if hasNestedMap(d, "identity") || vdir.Identity.Username != "" {
+		values["identity"] = []interface{}{map[string]interface{}{
+			"username":         vdir.Identity.Username,
+			"logon_method":     vdir.Identity.LogonMethod,
+			"password_version": d.Get("identity.0.password_version").(string),
+		}}
+	}
+	for key, value := range values {
+		if err = d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceVirtualDirectoryUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+
+	if d.HasChanges(PathKey, PhysicalPathKey, "identity") {
+		tflog.Debug(ctx, "Updating virtual directory: "+toJSON(id))
+
+		request := iis.UpdateVirtualDirectoryRequest{}
+		if d.HasChange(PathKey) {
+			request.Path = d.Get(PathKey).(string)
+		}
+		if d.HasChange(PhysicalPathKey) {
+			request.PhysicalPath = d.Get(PhysicalPathKey).(string)
+		}
+		if d.HasChange("identity") {
+			identity, diags := expandVirtualDirectoryIdentity(d)
+			if diags.HasError() {
+				return diags
+			}
+			if identity == nil {
+				// Removing the identity switches back to pass-through authentication
+				identity = &iis.VirtualDirectoryIdentity{}
+			}
+			request.Identity = identity
+		}
+
+		vdir, err := client.UpdateVirtualDirectory(ctx, id, request)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		tflog.Debug(ctx, "Updated virtual directory: "+toJSON(vdir))
+	}
+
+	// Re-read to update state
+	return resourceVirtualDirectoryRead(ctx, d, m)
+}
+
+func resourceVirtualDirectoryDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*iis.Client)
+	id := d.Id()
+	tflog.Debug(ctx, "Deleting virtual directory: "+toJSON(id))
+	err := client.DeleteVirtualDirectory(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tflog.Debug(ctx, "Deleted virtual directory: "+toJSON(id))
+	return nil
+}
+
+// expandVirtualDirectoryIdentity returns the configured connect-as identity,
+// the write-only password is only included on create or when its version or the username changes
+func expandVirtualDirectoryIdentity(d *schema.ResourceData) (*iis.VirtualDirectoryIdentity, diag.Diagnostics) {
+	if !hasNestedMap(d, "identity") {
+		return nil, nil
+	}
+	identity := &iis.VirtualDirectoryIdentity{
+		Username:    d.Get("identity.0.username").(string),
+		LogonMethod: d.Get("identity.0.logon_method").(string),
+	}
+	if d.IsNewResource() || d.HasChange("identity.0.password_version") || d.HasChange("identity.0.username") {
+		password, diags := getWriteOnlyString(d, "identity.0.password")
+		if diags.HasError() {
+			return nil, diags
+		}
+		identity.Password = password
+	}
+	return identity, nil
+}
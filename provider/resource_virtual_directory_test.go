@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func TestAccResourceVirtualDirectory_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: testAccCheckDestroy(server, "iis_virtual_directory", func(ctx context.Context, client *iis.Client, id string) error {
+			_, err := client.ReadVirtualDirectory(ctx, id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceVirtualDirectoryConfig(`C:\content\images`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_virtual_directory.site", "path", "/images"),
+					resource.TestCheckResourceAttr("iis_virtual_directory.site", "location", "test-site/images"),
+					resource.TestCheckResourceAttrPair("iis_virtual_directory.site", "website", "iis_website.test", "id"),
+					resource.TestCheckResourceAttr("iis_virtual_directory.site", "application", ""),
+					resource.TestCheckResourceAttr("iis_virtual_directory.app", "location", "test-site/app/shared"),
+					resource.TestCheckResourceAttrPair("iis_virtual_directory.app", "application", "iis_application.test", "id"),
+					resource.TestCheckResourceAttr("iis_virtual_directory.app", "identity.0.username", `CONTOSO\content`),
+					resource.TestCheckResourceAttr("iis_virtual_directory.app", "identity.0.logon_method", "network_cleartext"),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceVirtualDirectoryConfig(`C:\content\images-v2`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_virtual_directory.site", "physical_path", `C:\content\images-v2`),
+				),
+			},
+			{
+				ResourceName:      "iis_virtual_directory.site",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// A virtual directory deleted outside of terraform is recreated
+				PreConfig: func() {
+					client := server.Client()
+					vdirs, err := client.ListVirtualDirectories(context.Background(), "", "")
+					if err != nil {
+						t.Fatal(err)
+					}
+					for _, vdir := range vdirs {
+						if vdir.Path == "/images" {
+							if err := client.DeleteVirtualDirectory(context.Background(), vdir.ID); err != nil {
+								t.Fatal(err)
+							}
+						}
+					}
+				},
+				Config: testAccProviderConfig(server) + testAccResourceVirtualDirectoryConfig(`C:\content\images-v2`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_virtual_directory.site", "path", "/images"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceVirtualDirectoryConfig(physicalPath string) string {
+	return fmt.Sprintf(`
+resource "iis_website" "test" {
+  name          = "test-site"
+  physical_path = "C:\\inetpub\\wwwroot"
+
+  binding {
+    port = 8080
+  }
+}
+
+resource "iis_application" "test" {
+  path          = "/app"
+  physical_path = "C:\\inetpub\\app"
+  website       = iis_website.test.id
+}
+
+resource "iis_virtual_directory" "site" {
+  path          = "/images"
+  physical_path = %q
+  website       = iis_website.test.id
+}
+
+resource "iis_virtual_directory" "app" {
+  path          = "/shared"
+  physical_path = "\\\\fileserver\\shared"
+  application   = iis_application.test.id
+
+  identity {
+    username = "CONTOSO\\content"
+    password = "secret"
+  }
+}
+`, physicalPath)
+}
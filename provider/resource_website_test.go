@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+func TestAccResourceWebsite_basic(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: testAccCheckDestroy(server, "iis_website", func(ctx context.Context, client *iis.Client, id string) error {
+			_, err := client.ReadWebsite(ctx, id)
+			return err
+		}),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig(server) + testAccResourceWebsiteConfig(`C:\inetpub\wwwroot`, 8080),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_website.test", "name", "test-site"),
+					resource.TestCheckResourceAttr("iis_website.test", "status", "started"),
+					resource.TestCheckResourceAttrPair("iis_website.test", "application_pool", "iis_application_pool.test", "id"),
+					resource.TestCheckResourceAttr("iis_website.test", "binding.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("iis_website.test", "binding.*", map[string]string{
+						"protocol": "http",
+						"port":     "8080",
+					}),
+				),
+			},
+			{
+				Config: testAccProviderConfig(server) + testAccResourceWebsiteConfig(`C:\inetpub\site`, 8081),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("iis_website.test", "physical_path", `C:\inetpub\site`),
+					resource.TestCheckTypeSetElemNestedAttrs("iis_website.test", "binding.*", map[string]string{
+						"port": "8081",
+					}),
+				),
+			},
+			{
+				ResourceName:      "iis_website.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceWebsiteConfig(physicalPath string, port int) string {
+	return fmt.Sprintf(`
+resource "iis_application_pool" "test" {
+  name = "test-pool"
+}
+
+resource "iis_website" "test" {
+  name             = "test-site"
+  physical_path    = %q
+  application_pool = iis_application_pool.test.id
+
+  binding {
+    protocol = "http"
+    port     = %d
+  }
+}
+`, physicalPath, port)
+}
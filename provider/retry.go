@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+var retrySchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"max_attempts": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      5,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  "Total number of attempts per request, including the first one.",
+		},
+		"base_backoff": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1s",
+			ValidateFunc: validateDuration,
+			Description:  "Delay before the first retry (e.g. 500ms, 1s). Doubled on every further attempt.",
+		},
+		"max_backoff": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "16s",
+			ValidateFunc: validateDuration,
+			Description:  "Upper bound of the exponential backoff. A Retry-After header sent by the server may exceed it.",
+		},
+		"jitter": {
+			Type:         schema.TypeFloat,
+			Optional:     true,
+			Default:      0.2,
+			ValidateFunc: validation.FloatBetween(0, 1),
+			Description:  "Fraction of the backoff that is randomized.",
+		},
+		"rule": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Overrides which failures are retried for an HTTP method. By default GET, PUT, PATCH and DELETE retry 401, 429, 5xx and network errors, while POST only retries 401 and 429.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"method": {
+						Type:     schema.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+						}, true),
+					},
+					"status_codes": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeInt,
+							ValidateFunc: validation.IntBetween(400, 599),
+						},
+						Description: "Response status codes that are retried.",
+					},
+					"network_errors": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Retry requests failing without a response.",
+					},
+				},
+			},
+		},
+	},
+}
+
+func expandRetryPolicy(config []interface{}) iis.RetryPolicy {
+	policy := iis.DefaultRetryPolicy()
+	if len(config) == 0 || config[0] == nil {
+		return policy
+	}
+	retry := config[0].(map[string]interface{})
+	policy.MaxAttempts = retry["max_attempts"].(int)
+	policy.BaseBackoff, _ = time.ParseDuration(retry["base_backoff"].(string))
+	policy.MaxBackoff, _ = time.ParseDuration(retry["max_backoff"].(string))
+	policy.Jitter = retry["jitter"].(float64)
+	for _, entry := range retry["rule"].([]interface{}) {
+		rule := entry.(map[string]interface{})
+		statusCodes := make([]int, 0)
+		for _, code := range rule["status_codes"].(*schema.Set).List() {
+			statusCodes = append(statusCodes, code.(int))
+		}
+		policy.Methods[strings.ToUpper(rule["method"].(string))] = iis.RetryRule{
+			StatusCodes:   statusCodes,
+			NetworkErrors: rule["network_errors"].(bool),
+		}
+	}
+	return policy
+}
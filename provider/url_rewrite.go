@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
+)
+
+var rewriteConditionSchema = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"input": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Server variable the condition checks, e.g. {HTTPS} or {HTTP_HOST}",
+		},
+		"match_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "pattern",
+			ValidateFunc: validation.StringInSlice([]string{"pattern", "is_file", "is_directory"}, false),
+		},
+		"pattern": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"negate": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"ignore_case": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	},
+}
+
+// withUrlRewriteRule adds the name, priority, pattern and conditions shared by inbound and outbound rules to the schema
+func withUrlRewriteRule(schemas map[string]*schema.Schema) map[string]*schema.Schema {
+	shared := map[string]*schema.Schema{
+		NameKey: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+		"priority": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Zero-based position of the rule, rules are evaluated in order. New rules are added at the end if not set",
+		},
+		"pattern": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"pattern_syntax": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "regular_expression",
+			ValidateFunc: validation.StringInSlice([]string{"regular_expression", "wildcard", "exact_match"}, false),
+		},
+		"ignore_case": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"negate": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"stop_processing": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Skip the following rules if the rule matches",
+		},
+		"condition_match_constraints": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "all",
+			ValidateFunc: validation.StringInSlice([]string{"all", "any"}, false),
+		},
+		"track_all_captures": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"condition": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     rewriteConditionSchema,
+		},
+	}
+	for key, value := range shared {
+		schemas[key] = value
+	}
+	return withFeatureScope(schemas)
+}
+
+// setUrlRewriteScope sets the website or application of an imported rule or map from its section
+func setUrlRewriteScope(ctx context.Context, d *schema.ResourceData, client *iis.Client, section iis.UrlRewriteSection, parent *iis.Reference) error {
+	if scope := getFeatureScope(d); scope.WebsiteID != "" || scope.ApplicationID != "" {
+		return nil
+	}
+	if parent == nil {
+		return fmt.Errorf("%s does not reference its section", d.Id())
+	}
+	feature, err := client.ReadUrlRewriteSectionByID(ctx, section, parent.ID)
+	if err != nil {
+		return err
+	}
+	return setFeatureScope(ctx, d, client, *feature)
+}
+
+func expandRewriteConditions(list []interface{}) []iis.RewriteCondition {
+	conditions := make([]iis.RewriteCondition, 0, len(list))
+	for _, item := range list {
+		condition := item.(map[string]interface{})
+		conditions = append(conditions, iis.RewriteCondition{
+			Input:      condition["input"].(string),
+			MatchType:  condition["match_type"].(string),
+			Pattern:    condition["pattern"].(string),
+			Negate:     condition["negate"].(bool),
+			IgnoreCase: condition["ignore_case"].(bool),
+		})
+	}
+	return conditions
+}
+
+func flattenRewriteConditions(conditions []iis.RewriteCondition) []interface{} {
+	flattened := make([]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		flattened = append(flattened, map[string]interface{}{
+			"input":       condition.Input,
+			"match_type":  condition.MatchType,
+			"pattern":     condition.Pattern,
+			"negate":      condition.Negate,
+			"ignore_case": condition.IgnoreCase,
+		})
+	}
+	return flattened
+}
@@ -2,14 +2,36 @@ package provider
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/maxjoehnk/terraform-provider-iis/iis"
 )
 
 func getList(d *schema.ResourceData, key string) []interface{} {
 	return d.Get(key).([]interface{})
 }
 
+func getStringList(d *schema.ResourceData, key string) []string {
+	return toStrings(getList(d, key))
+}
+
+func toStrings(values []interface{}) []string {
+	list := make([]string, 0, len(values))
+	for _, value := range values {
+		if value != nil {
+			list = append(list, value.(string))
+		}
+	}
+	return list
+}
+
 func getNestedMap(d *schema.ResourceData, key string) map[string]interface{} {
 	return getList(d, key)[0].(map[string]interface{})
 }
@@ -25,3 +47,147 @@ func toJSON(obj interface{}) string {
 	}
 	return string(jsonBytes)
 }
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration (e.g. 30s, 5m): %w", k, err)}
+	}
+	return nil, nil
+}
+
+// validateUint32 accepts the range of an unsigned 32-bit IIS setting, the bound
+// is compared as int64 as it does not fit into int on 32-bit platforms
+func validateUint32(v interface{}, k string) ([]string, []error) {
+	if value := int64(v.(int)); value < 0 || value > math.MaxUint32 {
+		return nil, []error{fmt.Errorf("%q must be between 0 and %d, got %d", k, int64(math.MaxUint32), value)}
+	}
+	return nil, nil
+}
+
+// validateTimeSpan accepts Go ("20m") and ISO 8601 ("PT1H29M") durations
+func validateTimeSpan(v interface{}, k string) ([]string, []error) {
+	duration, err := iis.ParseTimeSpan(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%q: %w", k, err)}
+	}
+	if duration < 0 {
+		return nil, []error{fmt.Errorf("%q must not be negative", k)}
+	}
+	return nil, nil
+}
+
+// suppressEquivalentTimeSpan ignores differences in notation, e.g. "PT20M" and "20m"
+func suppressEquivalentTimeSpan(k, old, new string, d *schema.ResourceData) bool {
+	oldDuration, err := iis.ParseTimeSpan(old)
+	if err != nil {
+		return false
+	}
+	newDuration, err := iis.ParseTimeSpan(new)
+	if err != nil {
+		return false
+	}
+	return oldDuration == newDuration
+}
+
+// validateTimeOfDay accepts a time of day in 24-hour "hh:mm" notation
+func validateTimeOfDay(v interface{}, k string) ([]string, []error) {
+	if _, err := iis.ParseTimeOfDay(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q: %w", k, err)}
+	}
+	return nil, nil
+}
+
+// hashTimeOfDay hashes the normalized time of day, so "2:00" and "02:00" are the same set element
+func hashTimeOfDay(v interface{}) int {
+	timeOfDay, err := iis.ParseTimeOfDay(v.(string))
+	if err != nil {
+		return schema.HashString(v)
+	}
+	return schema.HashString(timeOfDay.String())
+}
+
+// isConfigured reports whether the attribute at key (e.g. "cpu.0.limit") is set
+// in the configuration, including explicit zero values like false or 0
+func isConfigured(d *schema.ResourceData, key string) bool {
+	value := d.GetRawConfig()
+	for _, part := range strings.Split(key, ".") {
+		if value.IsNull() || !value.IsKnown() {
+			return false
+		}
+		valueType := value.Type()
+		if index, err := strconv.Atoi(part); err == nil {
+			if !(valueType.IsListType() || valueType.IsTupleType()) || value.LengthInt() <= index {
+				return false
+			}
+			value = value.AsValueSlice()[index]
+			continue
+		}
+		if !valueType.IsObjectType() || !valueType.HasAttribute(part) {
+			return false
+		}
+		value = value.GetAttr(part)
+	}
+	return !value.IsNull()
+}
+
+func setConfiguredString(d *schema.ResourceData, key string, target *string) {
+	if isConfigured(d, key) {
+		*target = d.Get(key).(string)
+	}
+}
+
+func setConfiguredBool(d *schema.ResourceData, key string, target *bool) {
+	if isConfigured(d, key) {
+		*target = d.Get(key).(bool)
+	}
+}
+
+func setConfiguredInt64(d *schema.ResourceData, key string, target *int64) {
+	if isConfigured(d, key) {
+		*target = int64(d.Get(key).(int))
+	}
+}
+
+func setConfiguredTimeSpan(d *schema.ResourceData, key string, target *time.Duration) {
+	if isConfigured(d, key) {
+		// The value has already been checked by validateTimeSpan
+		*target, _ = iis.ParseTimeSpan(d.Get(key).(string))
+	}
+}
+
+// hasConfiguredChange reports whether a configured attribute or block (e.g.
+// "cpu") has to be sent, i.e. on create or if it changed
+func hasConfiguredChange(d *schema.ResourceData, key string) bool {
+	return isConfigured(d, key) && (d.IsNewResource() || d.HasChange(key))
+}
+
+// getConfiguredChange returns the configured value of key if it has to be
+// sent, nil keeps the current value on the server
+func getConfiguredChange[T any](d *schema.ResourceData, key string) *T {
+	if !hasConfiguredChange(d, key) {
+		return nil
+	}
+	value := d.Get(key).(T)
+	return &value
+}
+
+// getWriteOnlyString returns the configured value of a write-only attribute
+// (e.g. "identity.0.password"), which is not available through d.Get
+func getWriteOnlyString(d *schema.ResourceData, key string) (string, diag.Diagnostics) {
+	if !isConfigured(d, key) {
+		return "", nil
+	}
+	path := cty.Path{}
+	for _, part := range strings.Split(key, ".") {
+		if index, err := strconv.Atoi(part); err == nil {
+			path = path.IndexInt(index)
+		} else {
+			path = path.GetAttr(part)
+		}
+	}
+	value, diags := d.GetRawConfigAt(path)
+	if diags.HasError() || !value.IsKnown() || !value.Type().Equals(cty.String) {
+		return "", diags
+	}
+	return value.AsString(), nil
+}